@@ -18,12 +18,34 @@ func main() {
 			Name:    "elements-of-style",
 			Version: "1.0.0",
 			Rules: []tflint.Rule{
+				rules.NewAbbreviationsRule(),
+				rules.NewBlockOrderRule(),
+				rules.NewBooleanNamingRule(),
+				rules.NewCaseRule(),
 				rules.NewCommentsRule(),
+				rules.NewDescriptionRule(),
+				rules.NewDescriptionEchoRule(),
+				rules.NewDescriptionStyleRule(),
+				rules.NewEnvironmentInNameRule(),
+				rules.NewFileLayoutRule(),
+				rules.NewGenericNamesRule(),
 				rules.NewHungarianRule(),
 				rules.NewLengthRule(),
+				rules.NewMetaArgumentOrderRule(),
+				rules.NewMixedSeparatorsRule(),
+				rules.NewNamingPatternRule(),
+				rules.NewNumberedNamesRule(),
 				rules.NewReminderRule(),
+				rules.NewReservedNamesRule(),
+				rules.NewSensitiveNamesRule(),
 				rules.NewShoutRule(),
+				rules.NewSimilarNamesRule(),
+				rules.NewSpellingRule(),
+				rules.NewTagKeysRule(),
 				rules.NewTypeEchoRule(),
+				rules.NewUnusedDeclarationsRule(),
+				rules.NewVariableAnatomyRule(),
+				rules.NewVariableTypeRule(),
 			},
 		},
 	})
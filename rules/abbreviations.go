@@ -0,0 +1,174 @@
+// Copyright (c) 2025 Steve Taranto <staranto@gmail.com>.
+// SPDX-License-Identifier: Apache-2.0
+
+package rules
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/logger"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// defaultAbbreviations maps discouraged abbreviations to their expansion.
+var defaultAbbreviations = map[string]string{
+	"acct": "account",
+	"addr": "address",
+	"bkt":  "bucket",
+	"cert": "certificate",
+	"cfg":  "config",
+	"conn": "connection",
+	"ctx":  "context",
+	"dest": "destination",
+	"dir":  "directory",
+	"env":  "environment",
+	"grp":  "group",
+	"img":  "image",
+	"inst": "instance",
+	"mgmt": "management",
+	"msg":  "message",
+	"perm": "permission",
+	"pol":  "policy",
+	"pwd":  "password",
+	"req":  "request",
+	"resp": "response",
+	"src":  "source",
+	"srv":  "server",
+	"svc":  "service",
+	"tbl":  "table",
+	"usr":  "user",
+	"val":  "value",
+	"vol":  "volume",
+}
+
+var defaultAbbreviationsAllow = []string{
+	"env",
+}
+
+var defaultAbbreviationsConfig = abbreviationsRuleConfig{
+	Allow: defaultAbbreviationsAllow,
+	Level: "warning",
+}
+
+// abbreviationsRuleConfig represents the configuration for the
+// AbbreviationsRule.
+type abbreviationsRuleConfig struct {
+	Abbreviations map[string]string `hclext:"abbreviations,optional"`
+	Allow         []string          `hclext:"allow,optional"`
+	Inverted      []string          `hclext:"inverted,optional"`
+	Level         string            `hclext:"level,optional"`
+}
+
+// AbbreviationsRule checks whether a block's name uses discouraged
+// abbreviations.
+type AbbreviationsRule struct {
+	tflint.DefaultRule
+	Config abbreviationsRuleConfig
+
+	// names indexes the module's names for suggestions.
+	names nameIndex
+	// preferred maps each discouraged word to the preferred one. For the
+	// inverted entries, the expansion is discouraged instead.
+	preferred map[string]string
+}
+
+// Check checks whether the rule conditions are met.
+func (r *AbbreviationsRule) Check(runner tflint.Runner) error {
+	if err := runner.DecodeRuleConfig(r.Name(), &r.Config); err != nil {
+		return err
+	}
+
+	dictionary := map[string]string{}
+	maps.Copy(dictionary, defaultAbbreviations)
+	maps.Copy(dictionary, r.Config.Abbreviations)
+
+	for _, abbreviation := range r.Config.Inverted {
+		if _, ok := dictionary[abbreviation]; !ok {
+			return fmt.Errorf("unknown abbreviation '%s'", abbreviation)
+		}
+	}
+
+	r.preferred = map[string]string{}
+	for abbreviation, expansion := range dictionary {
+		if slices.Contains(r.Config.Inverted, abbreviation) {
+			r.preferred[expansion] = abbreviation
+		} else {
+			r.preferred[abbreviation] = expansion
+		}
+	}
+
+	names, err := indexNames(runner, allLintableBlocks)
+	if err != nil {
+		return err
+	}
+	r.names = names
+
+	return CheckBlocksAndLocals(runner, allLintableBlocks, r, checkForAbbreviations)
+}
+
+// preferredWord returns the word that should be used instead of word, or ""
+// if word is fine as it is.
+func (r *AbbreviationsRule) preferredWord(word string) string {
+	if slices.Contains(r.Config.Allow, word) {
+		return ""
+	}
+	return r.preferred[word]
+}
+
+// checkForAbbreviations checks if the name uses a discouraged abbreviation,
+// or the expansion of an inverted one.
+func checkForAbbreviations(runner tflint.Runner, r *AbbreviationsRule, block *hclext.Block, typ string, name string, _ string) {
+	for _, word := range tokenizeName(name) {
+		preferred := r.preferredWord(word)
+		if preferred == "" {
+			continue
+		}
+
+		message := fmt.Sprintf("'%s' uses the abbreviation '%s' for '%s'.", name, word, preferred)
+		if slices.Contains(r.Config.Inverted, preferred) {
+			message = fmt.Sprintf("'%s' uses '%s' instead of the abbreviation '%s'.", name, word, preferred)
+		}
+
+		message = withSuggestion(message, suggestName(name, nameKind(block, typ), r.names, func(w string) string {
+			if p := r.preferredWord(w); p != "" {
+				return p
+			}
+			return w
+		}))
+		if err := runner.EmitIssue(r, message, block.DefRange); err != nil {
+			logger.Error(err.Error())
+		}
+		logger.Debug(message)
+		return
+	}
+}
+
+// NewAbbreviationsRule returns a new rule.
+func NewAbbreviationsRule() *AbbreviationsRule {
+	rule := &AbbreviationsRule{}
+	rule.Config = defaultAbbreviationsConfig
+	return rule
+}
+
+// Enabled returns whether the rule is enabled by default.
+func (r *AbbreviationsRule) Enabled() bool {
+	return true
+}
+
+// Link returns the rule reference link.
+func (r *AbbreviationsRule) Link() string {
+	return "https://github.com/staranto/tflint-ruleset-elements-of-style/blob/main/docs/rules/eos_abbreviations.md"
+}
+
+// Name returns the rule name.
+func (r *AbbreviationsRule) Name() string {
+	return "eos_abbreviations"
+}
+
+// Severity returns the rule severity.
+func (r *AbbreviationsRule) Severity() tflint.Severity {
+	return toSeverity(r.Config.Level)
+}
@@ -0,0 +1,128 @@
+// Copyright (c) 2025 Steve Taranto <staranto@gmail.com>.
+// SPDX-License-Identifier: Apache-2.0
+
+package rules
+
+import (
+	"flag"
+	"fmt"
+	"testing"
+
+	"os"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+var abbreviationsDeep = flag.Bool("abbreviationsDeep", false, "enable deep assert")
+
+func TestAbbreviationsRule(t *testing.T) {
+	flag.Parse()
+
+	content, _ := os.ReadFile("testdata/abbreviations_test.tf")
+
+	abbreviations := helper.Issues{
+		{
+			Rule:    NewAbbreviationsRule(),
+			Message: makeAbbreviationMessage("svc_cfg", "svc", "service", "service_config"),
+			Range: hcl.Range{
+				Filename: "abbreviations_test.tf",
+				Start:    hcl.Pos{Line: 4, Column: 1},
+				End:      hcl.Pos{Line: 4, Column: 19},
+			},
+		},
+		{
+			Rule:    NewAbbreviationsRule(),
+			Message: makeAbbreviationMessage("mgmt_port", "mgmt", "management", "management_port"),
+			Range: hcl.Range{
+				Filename: "abbreviations_test.tf",
+				Start:    hcl.Pos{Line: 7, Column: 3},
+				End:      hcl.Pos{Line: 7, Column: 19},
+			},
+		},
+		{
+			Rule:    NewAbbreviationsRule(),
+			Message: makeAbbreviationMessage("log_bkt", "bkt", "bucket", "log_bucket"),
+			Range: hcl.Range{
+				Filename: "abbreviations_test.tf",
+				Start:    hcl.Pos{Line: 10, Column: 1},
+				End:      hcl.Pos{Line: 10, Column: 35},
+			},
+		},
+	}
+
+	cases := []struct {
+		Name    string
+		Config  string
+		Content string
+		Want    helper.Issues
+	}{
+		{
+			Name:    "abbreviations",
+			Content: string(content),
+			Want:    abbreviations,
+		},
+		{
+			Name: "inverted",
+			Config: `
+rule "eos_abbreviations" {
+  enabled       = true
+  abbreviations = { db = "database" }
+  inverted      = ["db"]
+}`,
+			Content: string(content),
+			Want: append(append(helper.Issues{}, abbreviations...),
+				&helper.Issue{
+					Rule:    NewAbbreviationsRule(),
+					Message: makeExpansionMessage("database_url", "database", "db", "db_url"),
+					Range: hcl.Range{
+						Filename: "abbreviations_test.tf",
+						Start:    hcl.Pos{Line: 20, Column: 3},
+						End:      hcl.Pos{Line: 20, Column: 40},
+					},
+				},
+			),
+		},
+	}
+
+	for _, tc := range cases {
+
+		// Run the tests and make sure the basic results are found...
+		files := map[string]string{"abbreviations_test.tf": tc.Content}
+		if tc.Config != "" {
+			files[".tflint.hcl"] = tc.Config
+		}
+		runner := helper.TestRunner(t, files)
+		rule := NewAbbreviationsRule()
+
+		// ... no errors.
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		// ... and the expected number of issues.
+		if len(runner.Issues) != len(tc.Want) {
+			t.Logf("Expected %d issues, got %d", len(tc.Want), len(runner.Issues))
+			for i, issue := range runner.Issues {
+				t.Logf("Issue %d: %s at %s", i, issue.Message, issue.Range)
+			}
+			t.Fatalf("Number of issues mismatch: got %d, want %d", len(runner.Issues), len(tc.Want))
+		}
+
+		t.Run(tc.Name, func(t *testing.T) {
+			if *abbreviationsDeep {
+				helper.AssertIssues(t, tc.Want, runner.Issues)
+			} else {
+				helper.AssertIssuesWithoutRange(t, tc.Want, runner.Issues)
+			}
+		})
+	}
+}
+
+func makeAbbreviationMessage(name string, word string, preferred string, suggestion string) string {
+	return fmt.Sprintf("'%s' uses the abbreviation '%s' for '%s'. Consider '%s'.", name, word, preferred, suggestion)
+}
+
+func makeExpansionMessage(name string, word string, preferred string, suggestion string) string {
+	return fmt.Sprintf("'%s' uses '%s' instead of the abbreviation '%s'. Consider '%s'.", name, word, preferred, suggestion)
+}
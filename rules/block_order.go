@@ -0,0 +1,153 @@
+// Copyright (c) 2025 Steve Taranto <staranto@gmail.com>.
+// SPDX-License-Identifier: Apache-2.0
+
+package rules
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/logger"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+var defaultBlockOrderConfig = blockOrderRuleConfig{
+	Alphabetical: false,
+	Level:        "warning",
+	Order:        []string{"terraform", "provider", "variable", "locals", "data", "resource", "output"},
+}
+
+// blockOrderRuleConfig represents the configuration for the BlockOrderRule.
+type blockOrderRuleConfig struct {
+	Alphabetical bool     `hclext:"alphabetical,optional"`
+	Level        string   `hclext:"level,optional"`
+	Order        []string `hclext:"order,optional"`
+}
+
+// BlockOrderRule checks whether the top-level blocks of a file are in the
+// configured order.
+type BlockOrderRule struct {
+	tflint.DefaultRule
+	Config blockOrderRuleConfig
+}
+
+// Check checks whether the rule conditions are met.
+func (r *BlockOrderRule) Check(runner tflint.Runner) error {
+	if err := runner.DecodeRuleConfig(r.Name(), &r.Config); err != nil {
+		return err
+	}
+
+	schema := &hclext.BodySchema{}
+	for _, typ := range r.Config.Order {
+		labels, ok := topLevelBlocks[typ]
+		if !ok {
+			return fmt.Errorf("unknown block type '%s'", typ)
+		}
+		schema.Blocks = append(schema.Blocks, hclext.BlockSchema{Type: typ, LabelNames: labels})
+	}
+
+	content, err := runner.GetModuleContent(schema, nil)
+	if err != nil {
+		return err
+	}
+
+	files := map[string][]*hclext.Block{}
+	var filenames []string
+	for _, block := range content.Blocks {
+		filename := block.DefRange.Filename
+		if _, ok := files[filename]; !ok {
+			filenames = append(filenames, filename)
+		}
+		files[filename] = append(files[filename], block)
+	}
+	slices.Sort(filenames)
+
+	for _, filename := range filenames {
+		blocks := files[filename]
+		slices.SortFunc(blocks, func(a, b *hclext.Block) int {
+			return a.DefRange.Start.Byte - b.DefRange.Start.Byte
+		})
+
+		r.checkTypeOrder(runner, blocks)
+		if r.Config.Alphabetical {
+			r.checkNameOrder(runner, blocks, "variable")
+			r.checkNameOrder(runner, blocks, "output")
+		}
+	}
+
+	return nil
+}
+
+// checkTypeOrder reports the first block of a file that comes after a block
+// of a type that is ordered after its own.
+func (r *BlockOrderRule) checkTypeOrder(runner tflint.Runner, blocks []*hclext.Block) {
+	var latest *hclext.Block
+	for _, block := range blocks {
+		if latest != nil && slices.Index(r.Config.Order, block.Type) < slices.Index(r.Config.Order, latest.Type) {
+			r.emit(runner, block, fmt.Sprintf("%s blocks", latest.Type))
+			return
+		}
+		latest = block
+	}
+}
+
+// checkNameOrder reports the first block of the given type in a file whose
+// name sorts before the name of the block of that type preceding it.
+func (r *BlockOrderRule) checkNameOrder(runner tflint.Runner, blocks []*hclext.Block, typ string) {
+	var previous *hclext.Block
+	for _, block := range blocks {
+		if block.Type != typ {
+			continue
+		}
+		if previous != nil && compareNames(block.Labels[0], previous.Labels[0]) < 0 {
+			r.emit(runner, block, describeBlock(previous))
+			return
+		}
+		previous = block
+	}
+}
+
+// emit reports the block as belonging before the description.
+func (r *BlockOrderRule) emit(runner tflint.Runner, block *hclext.Block, before string) {
+	message := fmt.Sprintf("%s should come before %s.", describeBlock(block), before)
+	if err := runner.EmitIssue(r, message, block.DefRange); err != nil {
+		logger.Error(err.Error())
+	}
+	logger.Debug(message)
+}
+
+// compareNames compares names case-insensitively, falling back to a
+// case-sensitive comparison so that the order is total.
+func compareNames(a string, b string) int {
+	return cmp.Or(strings.Compare(strings.ToLower(a), strings.ToLower(b)), strings.Compare(a, b))
+}
+
+// NewBlockOrderRule returns a new rule.
+func NewBlockOrderRule() *BlockOrderRule {
+	rule := &BlockOrderRule{}
+	rule.Config = defaultBlockOrderConfig
+	return rule
+}
+
+// Enabled returns whether the rule is enabled by default.
+func (r *BlockOrderRule) Enabled() bool {
+	return true
+}
+
+// Link returns the rule reference link.
+func (r *BlockOrderRule) Link() string {
+	return "https://github.com/staranto/tflint-ruleset-elements-of-style/blob/main/docs/rules/eos_block_order.md"
+}
+
+// Name returns the rule name.
+func (r *BlockOrderRule) Name() string {
+	return "eos_block_order"
+}
+
+// Severity returns the rule severity.
+func (r *BlockOrderRule) Severity() tflint.Severity {
+	return toSeverity(r.Config.Level)
+}
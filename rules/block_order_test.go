@@ -0,0 +1,145 @@
+// Copyright (c) 2025 Steve Taranto <staranto@gmail.com>.
+// SPDX-License-Identifier: Apache-2.0
+
+package rules
+
+import (
+	"flag"
+	"fmt"
+	"testing"
+
+	"os"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+var blockOrderDeep = flag.Bool("blockOrderDeep", false, "enable deep assert")
+
+func TestBlockOrderRule(t *testing.T) {
+	flag.Parse()
+
+	content, _ := os.ReadFile("testdata/block_order_test.tf")
+
+	cases := []struct {
+		Name    string
+		Config  string
+		Content string
+		Want    helper.Issues
+	}{
+		{
+			Name:    "block_order",
+			Content: string(content),
+			Want: helper.Issues{
+				{
+					Rule:    NewBlockOrderRule(),
+					Message: makeBlockOrderMessage(`provider "aws"`, "resource blocks"),
+					Range: hcl.Range{
+						Filename: "block_order_test.tf",
+						Start:    hcl.Pos{Line: 16, Column: 1},
+						End:      hcl.Pos{Line: 16, Column: 15},
+					},
+				},
+			},
+		},
+		{
+			Name: "alphabetical",
+			Config: `
+rule "eos_block_order" {
+  enabled      = true
+  alphabetical = true
+}`,
+			Content: string(content),
+			Want: helper.Issues{
+				{
+					Rule:    NewBlockOrderRule(),
+					Message: makeBlockOrderMessage(`provider "aws"`, "resource blocks"),
+					Range: hcl.Range{
+						Filename: "block_order_test.tf",
+						Start:    hcl.Pos{Line: 16, Column: 1},
+						End:      hcl.Pos{Line: 16, Column: 15},
+					},
+				},
+				{
+					Rule:    NewBlockOrderRule(),
+					Message: makeBlockOrderMessage(`variable "region"`, `variable "zone"`),
+					Range: hcl.Range{
+						Filename: "block_order_test.tf",
+						Start:    hcl.Pos{Line: 10, Column: 1},
+						End:      hcl.Pos{Line: 10, Column: 18},
+					},
+				},
+				{
+					Rule:    NewBlockOrderRule(),
+					Message: makeBlockOrderMessage(`output "bucket_arn"`, `output "bucket_name"`),
+					Range: hcl.Range{
+						Filename: "block_order_test.tf",
+						Start:    hcl.Pos{Line: 24, Column: 1},
+						End:      hcl.Pos{Line: 24, Column: 20},
+					},
+				},
+			},
+		},
+		{
+			Name: "order",
+			Config: `
+rule "eos_block_order" {
+  enabled = true
+  order   = ["terraform", "variable", "resource", "provider", "data", "output"]
+}`,
+			Content: string(content),
+			Want: helper.Issues{
+				{
+					Rule:    NewBlockOrderRule(),
+					Message: makeBlockOrderMessage(`data "aws_caller_identity" "current"`, "output blocks"),
+					Range: hcl.Range{
+						Filename: "block_order_test.tf",
+						Start:    hcl.Pos{Line: 32, Column: 1},
+						End:      hcl.Pos{Line: 32, Column: 37},
+					},
+				},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+
+		// Run the tests and make sure the basic results are found...
+		files := map[string]string{
+			"block_order_test.tf": tc.Content,
+			// Each file is ordered on its own.
+			"versions.tf": "terraform {}\n",
+		}
+		if tc.Config != "" {
+			files[".tflint.hcl"] = tc.Config
+		}
+		runner := helper.TestRunner(t, files)
+		rule := NewBlockOrderRule()
+
+		// ... no errors.
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		// ... and the expected number of issues.
+		if len(runner.Issues) != len(tc.Want) {
+			t.Logf("Expected %d issues, got %d", len(tc.Want), len(runner.Issues))
+			for i, issue := range runner.Issues {
+				t.Logf("Issue %d: %s at %s", i, issue.Message, issue.Range)
+			}
+			t.Fatalf("Number of issues mismatch: got %d, want %d", len(runner.Issues), len(tc.Want))
+		}
+
+		t.Run(tc.Name, func(t *testing.T) {
+			if *blockOrderDeep {
+				helper.AssertIssues(t, tc.Want, runner.Issues)
+			} else {
+				helper.AssertIssuesWithoutRange(t, tc.Want, runner.Issues)
+			}
+		})
+	}
+}
+
+func makeBlockOrderMessage(block string, before string) string {
+	return fmt.Sprintf("%s should come before %s.", block, before)
+}
@@ -0,0 +1,148 @@
+// Copyright (c) 2025 Steve Taranto <staranto@gmail.com>.
+// SPDX-License-Identifier: Apache-2.0
+
+package rules
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/logger"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// booleanBlocks are the blocks checked by the BooleanNamingRule. The type
+// attribute is needed to tell the bools apart.
+var booleanBlocks = []BlockDef{
+	{Typ: "variable", Labels: []string{"name"}, Attributes: []string{"type"}},
+}
+
+// booleanOpposites maps negative words to their positive form, for
+// suggestions.
+var booleanOpposites = map[string]string{
+	"disable": "enable",
+}
+
+var defaultBooleanNamingConfig = booleanNamingRuleConfig{
+	Negatives: []string{"disable", "no", "skip"},
+	Prefixes:  []string{"allow", "can", "create", "enable", "has", "is", "should", "use"},
+	Suffixes:  []string{"enabled"},
+	Level:     "warning",
+}
+
+// booleanNamingRuleConfig represents the configuration for the
+// BooleanNamingRule.
+type booleanNamingRuleConfig struct {
+	Negatives []string `hclext:"negatives,optional"`
+	Prefixes  []string `hclext:"prefixes,optional"`
+	Suffixes  []string `hclext:"suffixes,optional"`
+	Level     string   `hclext:"level,optional"`
+}
+
+// BooleanNamingRule checks whether bool variables are named as predicates.
+type BooleanNamingRule struct {
+	tflint.DefaultRule
+	Config booleanNamingRuleConfig
+
+	// names indexes the module's names for suggestions.
+	names nameIndex
+}
+
+// Check checks whether the rule conditions are met.
+func (r *BooleanNamingRule) Check(runner tflint.Runner) error {
+	if err := runner.DecodeRuleConfig(r.Name(), &r.Config); err != nil {
+		return err
+	}
+
+	names, err := indexNames(runner, allLintableBlocks)
+	if err != nil {
+		return err
+	}
+	r.names = names
+
+	return CheckBlocksAndLocals(runner, booleanBlocks, r, checkForBooleanNaming)
+}
+
+// checkForBooleanNaming checks if a bool variable's name reads as a predicate
+// and is not phrased in the negative.
+func checkForBooleanNaming(runner tflint.Runner, r *BooleanNamingRule, block *hclext.Block, typ string, name string, _ string) {
+	if typ != "variable" {
+		return
+	}
+	attr, ok := block.Body.Attributes["type"]
+	if !ok || hcl.ExprAsKeyword(attr.Expr) != "bool" {
+		return
+	}
+
+	words := tokenizeName(name)
+	if len(words) == 0 {
+		return
+	}
+	first, last := words[0], words[len(words)-1]
+
+	var message string
+	switch {
+	case slices.Contains(r.Config.Negatives, first):
+		message = fmt.Sprintf("'%s' is a bool with negative phrasing, which makes a double negative of false.", name)
+		if positive, ok := booleanOpposites[first]; ok {
+			message = withSuggestion(message, suggestName(name, nameKind(block, typ), r.names, func(w string) string {
+				if w == first {
+					return positive
+				}
+				return w
+			}))
+		}
+	case slices.Contains(r.Config.Prefixes, first), slices.Contains(r.Config.Suffixes, last):
+		return
+	default:
+		message = fmt.Sprintf("'%s' is a bool but does not read as a predicate.", name)
+		if len(r.Config.Prefixes) > 0 {
+			prefix := r.Config.Prefixes[0]
+			if slices.Contains(r.Config.Prefixes, "enable") {
+				prefix = "enable"
+			}
+			prefixed := false
+			message = withSuggestion(message, suggestName(name, nameKind(block, typ), r.names, func(w string) string {
+				if !prefixed {
+					prefixed = true
+					return prefix + nameSeparator(name) + w
+				}
+				return w
+			}))
+		}
+	}
+
+	if err := runner.EmitIssue(r, message, block.DefRange); err != nil {
+		logger.Error(err.Error())
+	}
+	logger.Debug(message)
+}
+
+// NewBooleanNamingRule returns a new rule.
+func NewBooleanNamingRule() *BooleanNamingRule {
+	rule := &BooleanNamingRule{}
+	rule.Config = defaultBooleanNamingConfig
+	return rule
+}
+
+// Enabled returns whether the rule is enabled by default.
+func (r *BooleanNamingRule) Enabled() bool {
+	return true
+}
+
+// Link returns the rule reference link.
+func (r *BooleanNamingRule) Link() string {
+	return "https://github.com/staranto/tflint-ruleset-elements-of-style/blob/main/docs/rules/eos_boolean_naming.md"
+}
+
+// Name returns the rule name.
+func (r *BooleanNamingRule) Name() string {
+	return "eos_boolean_naming"
+}
+
+// Severity returns the rule severity.
+func (r *BooleanNamingRule) Severity() tflint.Severity {
+	return toSeverity(r.Config.Level)
+}
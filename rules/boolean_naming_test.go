@@ -0,0 +1,163 @@
+// Copyright (c) 2025 Steve Taranto <staranto@gmail.com>.
+// SPDX-License-Identifier: Apache-2.0
+
+package rules
+
+import (
+	"flag"
+	"fmt"
+	"testing"
+
+	"os"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+var booleanNamingDeep = flag.Bool("booleanNamingDeep", false, "enable deep assert")
+
+func TestBooleanNamingRule(t *testing.T) {
+	flag.Parse()
+
+	content, _ := os.ReadFile("testdata/boolean_naming_test.tf")
+
+	cases := []struct {
+		Name    string
+		Config  string
+		Content string
+		Want    helper.Issues
+	}{
+		{
+			Name:    "boolean_naming",
+			Content: string(content),
+			Want: helper.Issues{
+				{
+					Rule:    NewBooleanNamingRule(),
+					Message: makePredicateMessage("monitoring", "enable_monitoring"),
+					Range: hcl.Range{
+						Filename: "boolean_naming_test.tf",
+						Start:    hcl.Pos{Line: 4, Column: 1},
+						End:      hcl.Pos{Line: 4, Column: 22},
+					},
+				},
+				{
+					Rule:    NewBooleanNamingRule(),
+					Message: makeNegativeMessage("disable_encryption") + " Consider 'enable_encryption'.",
+					Range: hcl.Range{
+						Filename: "boolean_naming_test.tf",
+						Start:    hcl.Pos{Line: 8, Column: 1},
+						End:      hcl.Pos{Line: 8, Column: 30},
+					},
+				},
+				{
+					Rule:    NewBooleanNamingRule(),
+					Message: makeNegativeMessage("no_public_ip"),
+					Range: hcl.Range{
+						Filename: "boolean_naming_test.tf",
+						Start:    hcl.Pos{Line: 13, Column: 1},
+						End:      hcl.Pos{Line: 13, Column: 24},
+					},
+				},
+			},
+		},
+		{
+			Name: "prefixes",
+			Config: `
+rule "eos_boolean_naming" {
+  enabled   = true
+  prefixes  = ["is", "has"]
+  suffixes  = []
+  negatives = ["disable"]
+}`,
+			Content: string(content),
+			Want: helper.Issues{
+				{
+					Rule:    NewBooleanNamingRule(),
+					Message: makePredicateMessage("monitoring", "is_monitoring"),
+					Range: hcl.Range{
+						Filename: "boolean_naming_test.tf",
+						Start:    hcl.Pos{Line: 4, Column: 1},
+						End:      hcl.Pos{Line: 4, Column: 22},
+					},
+				},
+				{
+					Rule:    NewBooleanNamingRule(),
+					Message: makeNegativeMessage("disable_encryption") + " Consider 'enable_encryption'.",
+					Range: hcl.Range{
+						Filename: "boolean_naming_test.tf",
+						Start:    hcl.Pos{Line: 8, Column: 1},
+						End:      hcl.Pos{Line: 8, Column: 30},
+					},
+				},
+				{
+					Rule:    NewBooleanNamingRule(),
+					Message: makePredicateMessage("no_public_ip", "is_no_public_ip"),
+					Range: hcl.Range{
+						Filename: "boolean_naming_test.tf",
+						Start:    hcl.Pos{Line: 13, Column: 1},
+						End:      hcl.Pos{Line: 13, Column: 24},
+					},
+				},
+				{
+					Rule:    NewBooleanNamingRule(),
+					Message: makePredicateMessage("enable_logging", "is_enable_logging"),
+					Range: hcl.Range{
+						Filename: "boolean_naming_test.tf",
+						Start:    hcl.Pos{Line: 20, Column: 1},
+						End:      hcl.Pos{Line: 20, Column: 26},
+					},
+				},
+				{
+					Rule:    NewBooleanNamingRule(),
+					Message: makePredicateMessage("versioning_enabled", "is_versioning_enabled"),
+					Range: hcl.Range{
+						Filename: "boolean_naming_test.tf",
+						Start:    hcl.Pos{Line: 28, Column: 1},
+						End:      hcl.Pos{Line: 28, Column: 30},
+					},
+				},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+
+		// Run the tests and make sure the basic results are found...
+		files := map[string]string{"boolean_naming_test.tf": tc.Content}
+		if tc.Config != "" {
+			files[".tflint.hcl"] = tc.Config
+		}
+		runner := helper.TestRunner(t, files)
+		rule := NewBooleanNamingRule()
+
+		// ... no errors.
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		// ... and the expected number of issues.
+		if len(runner.Issues) != len(tc.Want) {
+			t.Logf("Expected %d issues, got %d", len(tc.Want), len(runner.Issues))
+			for i, issue := range runner.Issues {
+				t.Logf("Issue %d: %s at %s", i, issue.Message, issue.Range)
+			}
+			t.Fatalf("Number of issues mismatch: got %d, want %d", len(runner.Issues), len(tc.Want))
+		}
+
+		t.Run(tc.Name, func(t *testing.T) {
+			if *booleanNamingDeep {
+				helper.AssertIssues(t, tc.Want, runner.Issues)
+			} else {
+				helper.AssertIssuesWithoutRange(t, tc.Want, runner.Issues)
+			}
+		})
+	}
+}
+
+func makePredicateMessage(name string, suggestion string) string {
+	return fmt.Sprintf("'%s' is a bool but does not read as a predicate. Consider '%s'.", name, suggestion)
+}
+
+func makeNegativeMessage(name string) string {
+	return fmt.Sprintf("'%s' is a bool with negative phrasing, which makes a double negative of false.", name)
+}
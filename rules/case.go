@@ -0,0 +1,254 @@
+// Copyright (c) 2025 Steve Taranto <staranto@gmail.com>.
+// SPDX-License-Identifier: Apache-2.0
+
+package rules
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/logger"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+var defaultCaseConfig = caseRuleConfig{
+	Convention: "snake",
+	Level:      "warning",
+}
+
+// caseRuleConfig represents the configuration for the CaseRule.
+type caseRuleConfig struct {
+	Convention  string            `hclext:"convention,optional"`
+	Conventions map[string]string `hclext:"conventions,optional"`
+	Level       string            `hclext:"level,optional"`
+}
+
+// CaseRule checks whether a block's name follows the case convention.
+type CaseRule struct {
+	tflint.DefaultRule
+	Config caseRuleConfig
+
+	// names indexes the module's names to avoid renaming into a collision.
+	names nameIndex
+	// targets counts the names of each kind that convert to the same name,
+	// to avoid renaming two names into one.
+	targets map[string]int
+	// traversals holds every reference in the module, for renaming.
+	traversals []hcl.Traversal
+}
+
+// Check checks whether the rule conditions are met.
+func (r *CaseRule) Check(runner tflint.Runner) error {
+	if err := runner.DecodeRuleConfig(r.Name(), &r.Config); err != nil {
+		return err
+	}
+
+	for _, convention := range r.conventions() {
+		if toCase("", convention) == "?" {
+			return fmt.Errorf("unknown case convention '%s'", convention)
+		}
+	}
+
+	names, err := indexNames(runner, allLintableBlocks)
+	if err != nil {
+		return err
+	}
+	r.names = names
+
+	r.targets = map[string]int{}
+	if err := CheckBlocksAndLocals(runner, allLintableBlocks, r, collectCaseTarget); err != nil {
+		return err
+	}
+
+	r.traversals = nil
+	diags := runner.WalkExpressions(tflint.ExprWalkFunc(func(expr hcl.Expression) hcl.Diagnostics {
+		if traversal, ok := expr.(*hclsyntax.ScopeTraversalExpr); ok {
+			r.traversals = append(r.traversals, traversal.Traversal)
+		}
+		return nil
+	}))
+	if diags.HasErrors() {
+		return diags
+	}
+
+	return CheckBlocksAndLocals(runner, allLintableBlocks, r, checkForCase)
+}
+
+// conventions returns the default convention and all its overrides.
+func (r *CaseRule) conventions() []string {
+	conventions := []string{r.Config.Convention}
+	for _, convention := range r.Config.Conventions {
+		conventions = append(conventions, convention)
+	}
+	return conventions
+}
+
+// convention returns the convention for a block. The name type (eg.
+// aws_instance) takes precedence over the block type (eg. resource).
+func (r *CaseRule) convention(block *hclext.Block, typ string) string {
+	if convention, ok := r.Config.Conventions[typ]; ok {
+		return convention
+	}
+	if convention, ok := r.Config.Conventions[block.Type]; ok {
+		return convention
+	}
+	return r.Config.Convention
+}
+
+// checkForCase checks if the name follows the case convention.
+func checkForCase(runner tflint.Runner, r *CaseRule, block *hclext.Block, typ string, name string, _ string) {
+	convention := r.convention(block, typ)
+	converted := toCase(name, convention)
+	if converted == name || converted == "" {
+		return
+	}
+
+	message := withSuggestion(fmt.Sprintf("'%s' does not follow the %s case convention.", name, convention), converted)
+	if err := runner.EmitIssueWithFix(r, message, block.DefRange, func(f tflint.Fixer) error {
+		// Renaming into an existing name would break the module, and so
+		// would renaming two names into the same one.
+		if r.names[nameKind(block, typ)][converted] || r.targets[nameKind(block, typ)+"."+converted] > 1 {
+			return tflint.ErrFixNotSupported
+		}
+		return r.rename(runner, f, block, typ, name, converted)
+	}); err != nil {
+		logger.Error(err.Error())
+	}
+	logger.Debug(message)
+}
+
+// collectCaseTarget counts the name under the name it converts to, if it does
+// not follow its convention.
+func collectCaseTarget(_ tflint.Runner, r *CaseRule, block *hclext.Block, typ string, name string, _ string) {
+	converted := toCase(name, r.convention(block, typ))
+	if converted != name && converted != "" {
+		r.targets[nameKind(block, typ)+"."+converted]++
+	}
+}
+
+// rename renames a block or local and every reference to it. Variables and
+// outputs are the module's interface and are never renamed. Renamed
+// resources and module calls get a moved block so that their state follows.
+func (r *CaseRule) rename(runner tflint.Runner, f tflint.Fixer, block *hclext.Block, typ string, name string, converted string) error {
+	if block.Type == "variable" || block.Type == "output" {
+		return tflint.ErrFixNotSupported
+	}
+	if !strings.HasSuffix(block.DefRange.Filename, ".tf") || len(block.LabelRanges) == 0 {
+		return tflint.ErrFixNotSupported
+	}
+
+	label := fmt.Sprintf(`"%s"`, converted)
+	if block.Type == "locals" {
+		label = converted
+	}
+	if err := f.ReplaceText(block.LabelRanges[len(block.LabelRanges)-1], label); err != nil {
+		return err
+	}
+
+	var address []string
+	switch block.Type {
+	case "locals":
+		address = []string{"local", name}
+	case "module":
+		address = []string{"module", name}
+	case "resource":
+		address = []string{typ, name}
+	case "data", "ephemeral":
+		address = []string{block.Type, typ, name}
+	default:
+		// Checks can't be referenced from within the module.
+		return nil
+	}
+
+	for _, traversal := range r.traversals {
+		if !hasAddress(traversal, address) {
+			continue
+		}
+		step := traversal[len(address)-1].(hcl.TraverseAttr)
+		if err := f.ReplaceText(step.SrcRange, "."+converted); err != nil {
+			return err
+		}
+	}
+
+	if block.Type == "resource" || block.Type == "module" {
+		syntaxBlock := findSyntaxBlock(runner, block.DefRange)
+		if syntaxBlock == nil {
+			return tflint.ErrFixNotSupported
+		}
+		prefix := strings.Join(address[:len(address)-1], ".")
+		moved := fmt.Sprintf("\n\nmoved {\n  from = %s.%s\n  to   = %s.%s\n}", prefix, name, prefix, converted)
+		if err := f.InsertTextAfter(syntaxBlock.Range(), moved); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// hasAddress reports whether a traversal begins with the given address.
+func hasAddress(traversal hcl.Traversal, address []string) bool {
+	if len(traversal) < len(address) || traversal.RootName() != address[0] {
+		return false
+	}
+	for i, part := range address[1:] {
+		step, ok := traversal[i+1].(hcl.TraverseAttr)
+		if !ok || step.Name != part {
+			return false
+		}
+	}
+	return true
+}
+
+// toCase converts a name to the given convention. It returns "?" for an
+// unknown convention.
+func toCase(name string, convention string) string {
+	words := tokenizeName(name)
+
+	switch convention {
+	case "snake":
+		return strings.Join(words, "_")
+	case "kebab":
+		return strings.Join(words, "-")
+	case "lower":
+		return strings.Join(words, "")
+	case "camel", "pascal":
+		for i := range words {
+			if i > 0 || convention == "pascal" {
+				words[i] = strings.ToUpper(words[i][:1]) + words[i][1:]
+			}
+		}
+		return strings.Join(words, "")
+	}
+
+	return "?"
+}
+
+// NewCaseRule returns a new rule.
+func NewCaseRule() *CaseRule {
+	rule := &CaseRule{}
+	rule.Config = defaultCaseConfig
+	return rule
+}
+
+// Enabled returns whether the rule is enabled by default.
+func (r *CaseRule) Enabled() bool {
+	return true
+}
+
+// Link returns the rule reference link.
+func (r *CaseRule) Link() string {
+	return "https://github.com/staranto/tflint-ruleset-elements-of-style/blob/main/docs/rules/eos_case.md"
+}
+
+// Name returns the rule name.
+func (r *CaseRule) Name() string {
+	return "eos_case"
+}
+
+// Severity returns the rule severity.
+func (r *CaseRule) Severity() tflint.Severity {
+	return toSeverity(r.Config.Level)
+}
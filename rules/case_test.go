@@ -0,0 +1,178 @@
+// Copyright (c) 2025 Steve Taranto <staranto@gmail.com>.
+// SPDX-License-Identifier: Apache-2.0
+
+package rules
+
+import (
+	"flag"
+	"fmt"
+	"testing"
+
+	"os"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+var caseDeep = flag.Bool("caseDeep", false, "enable deep assert")
+
+func TestCaseRule(t *testing.T) {
+	flag.Parse()
+
+	cases := []struct {
+		Name    string
+		Config  string
+		Content string
+		Want    helper.Issues
+		Fixed   string
+	}{
+		{
+			Name: "cased_names",
+			Config: `
+rule "eos_case" {
+  enabled     = true
+  conventions = { output = "kebab" }
+}`,
+			Content: func() string {
+				content, _ := os.ReadFile("testdata/case_test.tf")
+				return string(content)
+			}(),
+			Want: helper.Issues{
+				{
+					Rule:    NewCaseRule(),
+					Message: makeCaseMessage("bucketName", "snake", "bucket_name"),
+					Range: hcl.Range{
+						Filename: "case_test.tf",
+						Start:    hcl.Pos{Line: 4, Column: 1},
+						End:      hcl.Pos{Line: 4, Column: 22},
+					},
+				},
+				{
+					Rule:    NewCaseRule(),
+					Message: makeCaseMessage("logPrefix", "snake", "log_prefix"),
+					Range: hcl.Range{
+						Filename: "case_test.tf",
+						Start:    hcl.Pos{Line: 7, Column: 3},
+						End:      hcl.Pos{Line: 7, Column: 21},
+					},
+				},
+				{
+					Rule:    NewCaseRule(),
+					Message: makeCaseMessage("Log_Bucket", "snake", "log_bucket"),
+					Range: hcl.Range{
+						Filename: "case_test.tf",
+						Start:    hcl.Pos{Line: 10, Column: 1},
+						End:      hcl.Pos{Line: 10, Column: 38},
+					},
+				},
+				{
+					Rule:    NewCaseRule(),
+					Message: makeCaseMessage("bucket_arn", "kebab", "bucket-arn"),
+					Range: hcl.Range{
+						Filename: "case_test.tf",
+						Start:    hcl.Pos{Line: 14, Column: 1},
+						End:      hcl.Pos{Line: 14, Column: 20},
+					},
+				},
+			},
+			Fixed: func() string {
+				content, _ := os.ReadFile("testdata/case_fixed_test.tf")
+				return string(content)
+			}(),
+		},
+		{
+			Name: "colliding_names",
+			Content: `
+locals {
+  logPrefix  = "logs"
+  log-prefix = "logs"
+  logSuffix  = "gz"
+}
+
+output "log_path" {
+  value = "${local.logPrefix}/${local.log-prefix}.${local.logSuffix}"
+}`,
+			Want: helper.Issues{
+				{
+					Rule:    NewCaseRule(),
+					Message: makeCaseMessage("logPrefix", "snake", "log_prefix"),
+					Range: hcl.Range{
+						Filename: "case_test.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 22},
+					},
+				},
+				{
+					Rule:    NewCaseRule(),
+					Message: makeCaseMessage("log-prefix", "snake", "log_prefix"),
+					Range: hcl.Range{
+						Filename: "case_test.tf",
+						Start:    hcl.Pos{Line: 4, Column: 3},
+						End:      hcl.Pos{Line: 4, Column: 22},
+					},
+				},
+				{
+					Rule:    NewCaseRule(),
+					Message: makeCaseMessage("logSuffix", "snake", "log_suffix"),
+					Range: hcl.Range{
+						Filename: "case_test.tf",
+						Start:    hcl.Pos{Line: 5, Column: 3},
+						End:      hcl.Pos{Line: 5, Column: 20},
+					},
+				},
+			},
+			Fixed: `
+locals {
+  logPrefix  = "logs"
+  log-prefix = "logs"
+  log_suffix = "gz"
+}
+
+output "log_path" {
+  value = "${local.logPrefix}/${local.log-prefix}.${local.log_suffix}"
+}`,
+		},
+	}
+
+	for _, tc := range cases {
+
+		// Run the tests and make sure the basic results are found...
+		files := map[string]string{"case_test.tf": tc.Content}
+		if tc.Config != "" {
+			files[".tflint.hcl"] = tc.Config
+		}
+		runner := helper.TestRunner(t, files)
+		rule := NewCaseRule()
+
+		// ... no errors.
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		// ... and the expected number of issues.
+		if len(runner.Issues) != len(tc.Want) {
+			t.Logf("Expected %d issues, got %d", len(tc.Want), len(runner.Issues))
+			for i, issue := range runner.Issues {
+				t.Logf("Issue %d: %s at %s", i, issue.Message, issue.Range)
+			}
+			t.Fatalf("Number of issues mismatch: got %d, want %d", len(runner.Issues), len(tc.Want))
+		}
+
+		t.Run(tc.Name, func(t *testing.T) {
+			if *caseDeep {
+				helper.AssertIssues(t, tc.Want, runner.Issues)
+			} else {
+				helper.AssertIssuesWithoutRange(t, tc.Want, runner.Issues)
+			}
+
+			// ... and the expected fixes.
+			if tc.Fixed != "" {
+				helper.AssertChanges(t, map[string]string{"case_test.tf": tc.Fixed}, runner.Changes())
+			}
+		})
+	}
+}
+
+func makeCaseMessage(name string, convention string, suggestion string) string {
+	return fmt.Sprintf("'%s' does not follow the %s case convention. Consider '%s'.", name, convention, suggestion)
+}
@@ -9,7 +9,6 @@ import (
 	"strings"
 
 	"github.com/hashicorp/hcl/v2"
-	"github.com/hashicorp/hcl/v2/hclsyntax"
 	"github.com/terraform-linters/tflint-plugin-sdk/logger"
 	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
 )
@@ -67,16 +66,12 @@ func (r *CommentsRule) Check(runner tflint.Runner) error {
 }
 
 func (r *CommentsRule) checkComments(runner tflint.Runner, filename string, file *hcl.File) error {
-	tokens, diags := hclsyntax.LexConfig(file.Bytes, filename, hcl.InitialPos)
-	if diags.HasErrors() {
-		return diags
+	tokens, err := commentTokens(filename, file)
+	if err != nil {
+		return err
 	}
 
 	for _, token := range tokens {
-		if token.Type != hclsyntax.TokenComment {
-			continue
-		}
-
 		text := string(token.Bytes)
 
 		// Check for a block comment if enabled.
@@ -6,8 +6,10 @@ package rules
 import (
 	"fmt"
 	"strings"
+	"unicode"
 
 	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
 	"github.com/staranto/tflint-ruleset-elements-of-style/terraform"
 	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
 	"github.com/terraform-linters/tflint-plugin-sdk/logger"
@@ -19,6 +21,8 @@ type BlockDef struct {
 	Typ     string
 	Labels  []string
 	Synonym string
+	// Attributes are the names of the body attributes to fetch, if any.
+	Attributes []string
 }
 
 // allLintableBlocks defines all block types and their label structures to
@@ -39,10 +43,14 @@ var allLintableBlocks = []BlockDef{
 func buildBlockSchemas(defs []BlockDef) []hclext.BlockSchema {
 	var blocks []hclext.BlockSchema
 	for _, def := range defs {
+		body := &hclext.BodySchema{}
+		for _, attr := range def.Attributes {
+			body.Attributes = append(body.Attributes, hclext.AttributeSchema{Name: attr})
+		}
 		blocks = append(blocks, hclext.BlockSchema{
 			Type:       def.Typ,
 			LabelNames: def.Labels,
-			Body:       &hclext.BodySchema{},
+			Body:       body,
 		})
 	}
 	return blocks
@@ -106,12 +114,64 @@ func CheckBlocksAndLocals[T any](
 	}
 
 	for name, local := range locals {
-		checkFunc(runner, rule, &hclext.Block{DefRange: local.DefRange}, "local", name, "")
+		// Locals have no labels, but the name range plays the same role.
+		block := &hclext.Block{
+			Type:        "locals",
+			DefRange:    local.DefRange,
+			LabelRanges: []hcl.Range{local.Attribute.NameRange},
+		}
+		checkFunc(runner, rule, block, "local", name, "")
 	}
 
 	return nil
 }
 
+// describeBlock returns a block as it is written in a file, such as
+// `variable "region"` or `terraform`.
+func describeBlock(block *hclext.Block) string {
+	if len(block.Labels) == 0 {
+		return block.Type
+	}
+	return fmt.Sprintf(`%s "%s"`, block.Type, strings.Join(block.Labels, `" "`))
+}
+
+// findSyntaxBlock returns the native syntax block defined at defRange. It
+// returns nil if there is no such block, as is the case for JSON files.
+func findSyntaxBlock(runner tflint.Runner, defRange hcl.Range) *hclsyntax.Block {
+	file, err := runner.GetFile(defRange.Filename)
+	if err != nil || file == nil {
+		return nil
+	}
+
+	body, ok := file.Body.(*hclsyntax.Body)
+	if !ok {
+		return nil
+	}
+
+	for _, block := range body.Blocks {
+		if block.DefRange().Start.Byte == defRange.Start.Byte {
+			return block
+		}
+	}
+	return nil
+}
+
+// commentTokens returns the comment tokens of a file.
+func commentTokens(filename string, file *hcl.File) (hclsyntax.Tokens, error) {
+	tokens, diags := hclsyntax.LexConfig(file.Bytes, filename, hcl.InitialPos)
+	if diags.HasErrors() {
+		return nil, diags
+	}
+
+	var comments hclsyntax.Tokens
+	for _, token := range tokens {
+		if token.Type == hclsyntax.TokenComment {
+			comments = append(comments, token)
+		}
+	}
+	return comments, nil
+}
+
 // getLocals is a helper function to get local {} blocks since GetModuleContent
 // does not.
 func getLocals(runner tflint.Runner) (map[string]*terraform.Local, hcl.Diagnostics) {
@@ -123,6 +183,42 @@ func getLocals(runner tflint.Runner) (map[string]*terraform.Local, hcl.Diagnosti
 	return locals, nil
 }
 
+// tokenizeName splits a name into its lowercase words. Words are delimited by
+// '_', '-' and case transitions, so "primaryVPC_id" yields "primary", "vpc"
+// and "id". Digits stay with the word they follow.
+func tokenizeName(name string) []string {
+	var words []string
+	var word []rune
+
+	flush := func() {
+		if len(word) > 0 {
+			words = append(words, strings.ToLower(string(word)))
+			word = word[:0]
+		}
+	}
+
+	runes := []rune(name)
+	for i, ch := range runes {
+		if ch == '_' || ch == '-' {
+			flush()
+			continue
+		}
+
+		if unicode.IsUpper(ch) && len(word) > 0 {
+			prev := runes[i-1]
+			nextLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+			// "dbName" splits before the 'N', "HTTPServer" splits before the 'S'.
+			if unicode.IsLower(prev) || unicode.IsDigit(prev) || (unicode.IsUpper(prev) && nextLower) {
+				flush()
+			}
+		}
+		word = append(word, ch)
+	}
+	flush()
+
+	return words
+}
+
 // toSeverity converts a string level to a tflint.Severity.
 func toSeverity(level string) tflint.Severity {
 	switch strings.ToLower(level) {
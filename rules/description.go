@@ -0,0 +1,151 @@
+// Copyright (c) 2025 Steve Taranto <staranto@gmail.com>.
+// SPDX-License-Identifier: Apache-2.0
+
+package rules
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/logger"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// describedBlocks are the blocks that take a description.
+var describedBlocks = []BlockDef{
+	{Typ: "output", Labels: []string{"name"}, Attributes: []string{"description"}},
+	{Typ: "variable", Labels: []string{"name"}, Attributes: []string{"description"}},
+}
+
+var defaultDescriptionConfig = descriptionRuleConfig{
+	Level:             "warning",
+	Names:             true,
+	Outputs:           true,
+	Placeholders:      []string{"-", "...", "description", "fixme", "n/a", "none", "placeholder", "tbd", "todo", "xxx"},
+	SkipRootVariables: false,
+	Variables:         true,
+}
+
+// descriptionRuleConfig represents the configuration for the DescriptionRule.
+type descriptionRuleConfig struct {
+	Level             string   `hclext:"level,optional"`
+	Names             bool     `hclext:"names,optional"`
+	Outputs           bool     `hclext:"outputs,optional"`
+	Placeholders      []string `hclext:"placeholders,optional"`
+	SkipRootVariables bool     `hclext:"skip_root_variables,optional"`
+	Variables         bool     `hclext:"variables,optional"`
+}
+
+// DescriptionRule checks whether variables and outputs have a meaningful
+// description.
+type DescriptionRule struct {
+	tflint.DefaultRule
+	Config descriptionRuleConfig
+}
+
+// Check checks whether the rule conditions are met.
+func (r *DescriptionRule) Check(runner tflint.Runner) error {
+	if err := runner.DecodeRuleConfig(r.Name(), &r.Config); err != nil {
+		return err
+	}
+
+	path, err := runner.GetModulePath()
+	if err != nil {
+		return err
+	}
+	variables := r.Config.Variables && !(r.Config.SkipRootVariables && path.IsRoot())
+
+	content, err := runner.GetModuleContent(&hclext.BodySchema{
+		Blocks: buildBlockSchemas(describedBlocks),
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, block := range content.Blocks {
+		if block.Type == "variable" && !variables || block.Type == "output" && !r.Config.Outputs {
+			continue
+		}
+
+		attr, ok := block.Body.Attributes["description"]
+		if !ok {
+			r.emit(runner, fmt.Sprintf("%s has no description.", describeBlock(block)), block.DefRange)
+			continue
+		}
+
+		err := runner.EvaluateExpr(attr.Expr, func(description string) error {
+			if problem := r.problem(block.Labels[0], description); problem != "" {
+				r.emit(runner, fmt.Sprintf("%s has %s.", describeBlock(block), problem), attr.Expr.Range())
+			}
+			return nil
+		}, nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// problem returns what is wrong with a description, or "" if nothing is.
+func (r *DescriptionRule) problem(name string, description string) string {
+	trimmed := strings.TrimSpace(description)
+	if trimmed == "" {
+		return "an empty description"
+	}
+
+	lower := strings.ToLower(trimmed)
+	if slices.Contains(r.Config.Placeholders, lower) || slices.Contains(r.Config.Placeholders, strings.TrimRight(lower, ".")) {
+		return fmt.Sprintf("the placeholder description '%s'", trimmed)
+	}
+
+	if r.Config.Names && restatesName(name, trimmed) {
+		return "its own name as its description"
+	}
+
+	return ""
+}
+
+// restatesName reports whether a description is made of exactly the words of
+// a name, like "VPC ID." for vpc_id.
+func restatesName(name string, description string) bool {
+	return slices.Equal(descriptionWords(description), tokenizeName(name))
+}
+
+// emit reports a problem with a description.
+func (r *DescriptionRule) emit(runner tflint.Runner, message string, rng hcl.Range) {
+	if err := runner.EmitIssue(r, message, rng); err != nil {
+		logger.Error(err.Error())
+	}
+	logger.Debug(message)
+}
+
+// NewDescriptionRule returns a new rule.
+func NewDescriptionRule() *DescriptionRule {
+	rule := &DescriptionRule{}
+	rule.Config = defaultDescriptionConfig
+	return rule
+}
+
+// Enabled returns whether the rule is enabled by default.
+func (r *DescriptionRule) Enabled() bool {
+	return true
+}
+
+// Link returns the rule reference link.
+func (r *DescriptionRule) Link() string {
+	return "https://github.com/staranto/tflint-ruleset-elements-of-style/blob/main/docs/rules/eos_description.md"
+}
+
+// Name returns the rule name.
+func (r *DescriptionRule) Name() string {
+	return "eos_description"
+}
+
+// Severity returns the rule severity.
+func (r *DescriptionRule) Severity() tflint.Severity {
+	return toSeverity(r.Config.Level)
+}
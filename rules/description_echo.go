@@ -0,0 +1,152 @@
+// Copyright (c) 2025 Steve Taranto <staranto@gmail.com>.
+// SPDX-License-Identifier: Apache-2.0
+
+package rules
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/logger"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+var defaultDescriptionEchoConfig = descriptionEchoRuleConfig{
+	Level: "warning",
+	StopWords: []string{
+		"a", "an", "and", "as", "be", "by", "for", "from", "in", "is", "it",
+		"of", "on", "or", "output", "set", "that", "the", "this", "to", "use",
+		"used", "value", "variable", "which", "with",
+	},
+}
+
+// descriptionEchoRuleConfig represents the configuration for the
+// DescriptionEchoRule.
+type descriptionEchoRuleConfig struct {
+	Level     string   `hclext:"level,optional"`
+	StopWords []string `hclext:"stop_words,optional"`
+}
+
+// DescriptionEchoRule checks whether descriptions say more than the names
+// they describe.
+type DescriptionEchoRule struct {
+	tflint.DefaultRule
+	Config descriptionEchoRuleConfig
+}
+
+// Check checks whether the rule conditions are met.
+func (r *DescriptionEchoRule) Check(runner tflint.Runner) error {
+	if err := runner.DecodeRuleConfig(r.Name(), &r.Config); err != nil {
+		return err
+	}
+
+	content, err := runner.GetModuleContent(&hclext.BodySchema{
+		Blocks: buildBlockSchemas(describedBlocks),
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, block := range content.Blocks {
+		attr, ok := block.Body.Attributes["description"]
+		if !ok {
+			continue
+		}
+
+		err := runner.EvaluateExpr(attr.Expr, func(description string) error {
+			if !r.echoes(block.Labels[0], description) {
+				return nil
+			}
+
+			message := fmt.Sprintf("%s has a description that only restates its name.", describeBlock(block))
+			if err := runner.EmitIssue(r, message, attr.Expr.Range()); err != nil {
+				logger.Error(err.Error())
+			}
+			logger.Debug(message)
+			return nil
+		}, nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// echoes reports whether every word of a description, other than the stop
+// words, is a word of the name, and there is at least one such word. Empty descriptions and exact restatements of
+// the name are left to eos_description.
+func (r *DescriptionEchoRule) echoes(name string, description string) bool {
+	words := descriptionWords(description)
+	if len(words) == 0 || restatesName(name, description) {
+		return false
+	}
+
+	nameWords := map[string]bool{}
+	for _, word := range tokenizeName(name) {
+		nameWords[singular(word)] = true
+	}
+
+	echoed := false
+	for _, word := range words {
+		word = singular(word)
+		if nameWords[word] {
+			echoed = true
+		} else if !slices.ContainsFunc(r.Config.StopWords, func(stop string) bool {
+			return singular(stop) == word
+		}) {
+			return false
+		}
+	}
+	return echoed
+}
+
+// descriptionWords splits a description into lowercase words the way names
+// are split, so "VPC ID." and "vpc_id" yield the same words.
+func descriptionWords(description string) []string {
+	var words []string
+	for _, field := range strings.FieldsFunc(description, func(ch rune) bool {
+		return !unicode.IsLetter(ch) && !unicode.IsDigit(ch) && ch != '_' && ch != '-'
+	}) {
+		words = append(words, tokenizeName(field)...)
+	}
+	return words
+}
+
+// singular strips a plural 's' so that "tags" and "tag" are the same word.
+func singular(word string) string {
+	if len(word) > 3 && strings.HasSuffix(word, "s") && !strings.HasSuffix(word, "ss") {
+		return strings.TrimSuffix(word, "s")
+	}
+	return word
+}
+
+// NewDescriptionEchoRule returns a new rule.
+func NewDescriptionEchoRule() *DescriptionEchoRule {
+	rule := &DescriptionEchoRule{}
+	rule.Config = defaultDescriptionEchoConfig
+	return rule
+}
+
+// Enabled returns whether the rule is enabled by default.
+func (r *DescriptionEchoRule) Enabled() bool {
+	return true
+}
+
+// Link returns the rule reference link.
+func (r *DescriptionEchoRule) Link() string {
+	return "https://github.com/staranto/tflint-ruleset-elements-of-style/blob/main/docs/rules/eos_description_echo.md"
+}
+
+// Name returns the rule name.
+func (r *DescriptionEchoRule) Name() string {
+	return "eos_description_echo"
+}
+
+// Severity returns the rule severity.
+func (r *DescriptionEchoRule) Severity() tflint.Severity {
+	return toSeverity(r.Config.Level)
+}
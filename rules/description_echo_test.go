@@ -0,0 +1,130 @@
+// Copyright (c) 2025 Steve Taranto <staranto@gmail.com>.
+// SPDX-License-Identifier: Apache-2.0
+
+package rules
+
+import (
+	"flag"
+	"fmt"
+	"testing"
+
+	"os"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+var descriptionEchoDeep = flag.Bool("descriptionEchoDeep", false, "enable deep assert")
+
+func TestDescriptionEchoRule(t *testing.T) {
+	flag.Parse()
+
+	content, _ := os.ReadFile("testdata/description_echo_test.tf")
+
+	cases := []struct {
+		Name    string
+		Config  string
+		Content string
+		Want    helper.Issues
+	}{
+		{
+			Name:    "description_echo",
+			Content: string(content),
+			Want: helper.Issues{
+				{
+					Rule:    NewDescriptionEchoRule(),
+					Message: makeDescriptionEchoMessage(`variable "vpc_id"`),
+					Range: hcl.Range{
+						Filename: "description_echo_test.tf",
+						Start:    hcl.Pos{Line: 6, Column: 17},
+						End:      hcl.Pos{Line: 6, Column: 29},
+					},
+				},
+				{
+					Rule:    NewDescriptionEchoRule(),
+					Message: makeDescriptionEchoMessage(`variable "tags"`),
+					Range: hcl.Range{
+						Filename: "description_echo_test.tf",
+						Start:    hcl.Pos{Line: 11, Column: 17},
+						End:      hcl.Pos{Line: 11, Column: 37},
+					},
+				},
+				{
+					Rule:    NewDescriptionEchoRule(),
+					Message: makeDescriptionEchoMessage(`output "bucket_name"`),
+					Range: hcl.Range{
+						Filename: "description_echo_test.tf",
+						Start:    hcl.Pos{Line: 16, Column: 17},
+						End:      hcl.Pos{Line: 18, Column: 6},
+					},
+				},
+			},
+		},
+		{
+			Name: "stop_words",
+			Config: `
+rule "eos_description_echo" {
+  enabled    = true
+  stop_words = ["the", "of", "for", "log", "policies"]
+}`,
+			Content: string(content),
+			Want: helper.Issues{
+				{
+					Rule:    NewDescriptionEchoRule(),
+					Message: makeDescriptionEchoMessage(`variable "vpc_id"`),
+					Range: hcl.Range{
+						Filename: "description_echo_test.tf",
+						Start:    hcl.Pos{Line: 6, Column: 17},
+						End:      hcl.Pos{Line: 6, Column: 29},
+					},
+				},
+				{
+					Rule:    NewDescriptionEchoRule(),
+					Message: makeDescriptionEchoMessage(`output "bucket_name"`),
+					Range: hcl.Range{
+						Filename: "description_echo_test.tf",
+						Start:    hcl.Pos{Line: 16, Column: 17},
+						End:      hcl.Pos{Line: 18, Column: 6},
+					},
+				},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+
+		// Run the tests and make sure the basic results are found...
+		files := map[string]string{"description_echo_test.tf": tc.Content}
+		if tc.Config != "" {
+			files[".tflint.hcl"] = tc.Config
+		}
+		runner := helper.TestRunner(t, files)
+		rule := NewDescriptionEchoRule()
+
+		// ... no errors.
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		// ... and the expected number of issues.
+		if len(runner.Issues) != len(tc.Want) {
+			t.Logf("Expected %d issues, got %d", len(tc.Want), len(runner.Issues))
+			for i, issue := range runner.Issues {
+				t.Logf("Issue %d: %s at %s", i, issue.Message, issue.Range)
+			}
+			t.Fatalf("Number of issues mismatch: got %d, want %d", len(runner.Issues), len(tc.Want))
+		}
+
+		t.Run(tc.Name, func(t *testing.T) {
+			if *descriptionEchoDeep {
+				helper.AssertIssues(t, tc.Want, runner.Issues)
+			} else {
+				helper.AssertIssuesWithoutRange(t, tc.Want, runner.Issues)
+			}
+		})
+	}
+}
+
+func makeDescriptionEchoMessage(block string) string {
+	return fmt.Sprintf("%s has a description that only restates its name.", block)
+}
@@ -0,0 +1,231 @@
+// Copyright (c) 2025 Steve Taranto <staranto@gmail.com>.
+// SPDX-License-Identifier: Apache-2.0
+
+package rules
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/logger"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+var (
+	// proseFieldParser matches the white space separated fields of prose.
+	proseFieldParser = regexp.MustCompile(`\S+`)
+	// trailingSpaceParser matches the white space at the end of each line.
+	trailingSpaceParser = regexp.MustCompile(`(?m)[ \t]+$`)
+)
+
+var defaultDescriptionStyleConfig = descriptionStyleRuleConfig{
+	Acronyms: []string{
+		"ACL", "ACM", "AD", "ALB", "AMI", "API", "ARN", "ASG", "AWS", "AZ",
+		"CA", "CDN", "CI", "CIDR", "CPU", "CSV", "DB", "DNS", "EBS", "EC2",
+		"ECR", "ECS", "EFS", "EKS", "ELB", "FQDN", "GCP", "GCS", "GKE", "GPU",
+		"HTTP", "HTTPS", "IAM", "ID", "IP", "IPV4", "IPV6", "JSON", "JWT", "KMS",
+		"MFA", "NAT", "NLB", "OIDC", "OS", "RAM", "RDS", "S3", "SAML", "SG",
+		"SNS", "SQL", "SQS", "SSH", "SSL", "SSM", "SSO", "TCP", "TLS", "TTL",
+		"UDP", "URI", "URL", "UTC", "UUID", "VM", "VPC", "VPN", "YAML",
+	},
+	Level:   "warning",
+	Openers: []string{"the output", "the variable", "this output", "this variable"},
+}
+
+// descriptionStyleRuleConfig represents the configuration for the
+// DescriptionStyleRule.
+type descriptionStyleRuleConfig struct {
+	Acronyms []string `hclext:"acronyms,optional"`
+	Level    string   `hclext:"level,optional"`
+	Openers  []string `hclext:"openers,optional"`
+}
+
+// DescriptionStyleRule checks whether descriptions are written as prose.
+type DescriptionStyleRule struct {
+	tflint.DefaultRule
+	Config descriptionStyleRuleConfig
+}
+
+// Check checks whether the rule conditions are met.
+func (r *DescriptionStyleRule) Check(runner tflint.Runner) error {
+	if err := runner.DecodeRuleConfig(r.Name(), &r.Config); err != nil {
+		return err
+	}
+
+	content, err := runner.GetModuleContent(&hclext.BodySchema{
+		Blocks: buildBlockSchemas(describedBlocks),
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, block := range content.Blocks {
+		attr, ok := block.Body.Attributes["description"]
+		if !ok {
+			continue
+		}
+
+		// Only literal strings, and not JSON, have text to point into.
+		template, ok := attr.Expr.(*hclsyntax.TemplateExpr)
+		if !ok || slices.ContainsFunc(template.Parts, func(part hclsyntax.Expression) bool {
+			_, literal := part.(*hclsyntax.LiteralValueExpr)
+			return !literal
+		}) {
+			continue
+		}
+
+		file, err := runner.GetFile(attr.Range.Filename)
+		if err != nil {
+			return err
+		}
+		r.checkDescription(runner, file.Bytes, template.SrcRange)
+	}
+
+	return nil
+}
+
+// checkDescription checks the source text of a description. The text is
+// checked as written, so that the issues point at the exact characters.
+func (r *DescriptionStyleRule) checkDescription(runner tflint.Runner, src []byte, rng hcl.Range) {
+	// Strip the quotes, or the heredoc marker lines.
+	start, end := rng.Start.Byte+1, rng.End.Byte-1
+	if bytes.HasPrefix(src[rng.Start.Byte:], []byte("<<")) {
+		start = rng.Start.Byte + bytes.IndexByte(src[rng.Start.Byte:rng.End.Byte], '\n') + 1
+		end = rng.Start.Byte + bytes.LastIndexByte(src[rng.Start.Byte:rng.End.Byte], '\n')
+	}
+	if start >= end {
+		return
+	}
+	text := string(src[start:end])
+
+	// Empty descriptions are eos_description's business.
+	fields := proseFieldParser.FindAllStringIndex(text, -1)
+	if len(fields) == 0 {
+		return
+	}
+
+	emit := func(message string, from int, to int) {
+		issueRange := offsetRange(src, rng, start+from, start+to)
+		if err := runner.EmitIssue(r, message, issueRange); err != nil {
+			logger.Error(err.Error())
+		}
+		logger.Debug(message)
+	}
+
+	first, last := fields[0], fields[len(fields)-1]
+	if ch, _ := utf8.DecodeRuneInString(text[first[0]:]); unicode.IsLower(ch) {
+		emit("Description should start with a capital letter.", first[0], first[1])
+	}
+
+	for _, opener := range r.Config.Openers {
+		prefix := text[first[0]:min(first[0]+len(opener), len(text))]
+		rest := text[first[0]+len(prefix):]
+		if strings.EqualFold(prefix, opener) && (rest == "" || !unicode.IsLetter([]rune(rest)[0])) {
+			emit(fmt.Sprintf("Description should not start with '%s'.", prefix), first[0], first[0]+len(prefix))
+			break
+		}
+	}
+
+	if ending := strings.TrimRight(text[last[0]:last[1]], `)"'`+"`"); !strings.HasSuffix(ending, ".") &&
+		!strings.HasSuffix(ending, "!") && !strings.HasSuffix(ending, "?") {
+		emit("Description should end with a period.", last[0], last[1])
+	}
+
+	for _, space := range trailingSpaceParser.FindAllStringIndex(text, -1) {
+		emit("Description has trailing white space.", space[0], space[1])
+	}
+
+	for _, field := range fields {
+		for _, word := range r.shoutedWords(text, field[0], field[1]) {
+			emit(fmt.Sprintf("'%s' should not be all uppercase.", text[word[0]:word[1]]), word[0], word[1])
+		}
+	}
+}
+
+// shoutedWords returns the offsets of the all uppercase words in a field of
+// text. Fields that look like code, such as `aws_iam_role` or "var.name", and
+// known acronyms are not words that shout.
+func (r *DescriptionStyleRule) shoutedWords(text string, from int, to int) [][]int {
+	field := text[from:to]
+	trimmed := strings.TrimLeftFunc(field, func(ch rune) bool {
+		return !unicode.IsLetter(ch) && !unicode.IsDigit(ch)
+	})
+	from += len(field) - len(trimmed)
+	field = strings.TrimRightFunc(trimmed, func(ch rune) bool {
+		return !unicode.IsLetter(ch) && !unicode.IsDigit(ch)
+	})
+	if strings.ContainsAny(field, "_.:/=`") {
+		return nil
+	}
+
+	var words [][]int
+	for _, part := range strings.Split(field, "-") {
+		letters := strings.IndexFunc(part, unicode.IsLetter) >= 0 && len(strings.TrimFunc(part, unicode.IsDigit)) > 1
+		if letters && isShouted(part) && !r.isAcronym(part) && !r.isAcronym(strings.TrimSuffix(part, "S")) {
+			words = append(words, []int{from, from + len(part)})
+		}
+		from += len(part) + 1
+	}
+	return words
+}
+
+// isAcronym reports whether a word is one of the known acronyms.
+func (r *DescriptionStyleRule) isAcronym(word string) bool {
+	return slices.ContainsFunc(r.Config.Acronyms, func(acronym string) bool {
+		return strings.EqualFold(acronym, word)
+	})
+}
+
+// offsetRange returns the range of the bytes from start to end, which lie
+// within rng.
+func offsetRange(src []byte, rng hcl.Range, start int, end int) hcl.Range {
+	pos := func(offset int) hcl.Pos {
+		p := rng.Start
+		for _, ch := range string(src[p.Byte:offset]) {
+			if ch == '\n' {
+				p.Line++
+				p.Column = 1
+			} else {
+				p.Column++
+			}
+		}
+		p.Byte = offset
+		return p
+	}
+	return hcl.Range{Filename: rng.Filename, Start: pos(start), End: pos(end)}
+}
+
+// NewDescriptionStyleRule returns a new rule.
+func NewDescriptionStyleRule() *DescriptionStyleRule {
+	rule := &DescriptionStyleRule{}
+	rule.Config = defaultDescriptionStyleConfig
+	return rule
+}
+
+// Enabled returns whether the rule is enabled by default.
+func (r *DescriptionStyleRule) Enabled() bool {
+	return true
+}
+
+// Link returns the rule reference link.
+func (r *DescriptionStyleRule) Link() string {
+	return "https://github.com/staranto/tflint-ruleset-elements-of-style/blob/main/docs/rules/eos_description_style.md"
+}
+
+// Name returns the rule name.
+func (r *DescriptionStyleRule) Name() string {
+	return "eos_description_style"
+}
+
+// Severity returns the rule severity.
+func (r *DescriptionStyleRule) Severity() tflint.Severity {
+	return toSeverity(r.Config.Level)
+}
@@ -0,0 +1,252 @@
+// Copyright (c) 2025 Steve Taranto <staranto@gmail.com>.
+// SPDX-License-Identifier: Apache-2.0
+
+package rules
+
+import (
+	"flag"
+	"fmt"
+	"testing"
+
+	"os"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+var descriptionStyleDeep = flag.Bool("descriptionStyleDeep", false, "enable deep assert")
+
+func TestDescriptionStyleRule(t *testing.T) {
+	flag.Parse()
+
+	content, _ := os.ReadFile("testdata/description_style_test.tf")
+
+	cases := []struct {
+		Name    string
+		Config  string
+		Content string
+		Want    helper.Issues
+	}{
+		{
+			Name:    "description_style",
+			Content: string(content),
+			Want: helper.Issues{
+				{
+					Rule:    NewDescriptionStyleRule(),
+					Message: "Description should start with a capital letter.",
+					Range: hcl.Range{
+						Filename: "description_style_test.tf",
+						Start:    hcl.Pos{Line: 6, Column: 18},
+						End:      hcl.Pos{Line: 6, Column: 21},
+					},
+				},
+				{
+					Rule:    NewDescriptionStyleRule(),
+					Message: makeDescriptionOpenerMessage("The variable"),
+					Range: hcl.Range{
+						Filename: "description_style_test.tf",
+						Start:    hcl.Pos{Line: 11, Column: 18},
+						End:      hcl.Pos{Line: 11, Column: 30},
+					},
+				},
+				{
+					Rule:    NewDescriptionStyleRule(),
+					Message: "Description should end with a period.",
+					Range: hcl.Range{
+						Filename: "description_style_test.tf",
+						Start:    hcl.Pos{Line: 11, Column: 54},
+						End:      hcl.Pos{Line: 11, Column: 58},
+					},
+				},
+				{
+					Rule:    NewDescriptionStyleRule(),
+					Message: "Description has trailing white space.",
+					Range: hcl.Range{
+						Filename: "description_style_test.tf",
+						Start:    hcl.Pos{Line: 16, Column: 60},
+						End:      hcl.Pos{Line: 16, Column: 61},
+					},
+				},
+				{
+					Rule:    NewDescriptionStyleRule(),
+					Message: makeDescriptionShoutMessage("NOT"),
+					Range: hcl.Range{
+						Filename: "description_style_test.tf",
+						Start:    hcl.Pos{Line: 16, Column: 36},
+						End:      hcl.Pos{Line: 16, Column: 39},
+					},
+				},
+				{
+					Rule:    NewDescriptionStyleRule(),
+					Message: "Description has trailing white space.",
+					Range: hcl.Range{
+						Filename: "description_style_test.tf",
+						Start:    hcl.Pos{Line: 22, Column: 31},
+						End:      hcl.Pos{Line: 22, Column: 32},
+					},
+				},
+				{
+					Rule:    NewDescriptionStyleRule(),
+					Message: makeDescriptionShoutMessage("IMPORTANT"),
+					Range: hcl.Range{
+						Filename: "description_style_test.tf",
+						Start:    hcl.Pos{Line: 23, Column: 5},
+						End:      hcl.Pos{Line: 23, Column: 14},
+					},
+				},
+				{
+					Rule:    NewDescriptionStyleRule(),
+					Message: "Description should end with a period.",
+					Range: hcl.Range{
+						Filename: "description_style_test.tf",
+						Start:    hcl.Pos{Line: 23, Column: 53},
+						End:      hcl.Pos{Line: 23, Column: 61},
+					},
+				},
+			},
+		},
+		{
+			Name: "acronyms",
+			Config: `
+rule "eos_description_style" {
+  enabled  = true
+  acronyms = ["not", "important"]
+  openers  = []
+}`,
+			Content: string(content),
+			Want: helper.Issues{
+				{
+					Rule:    NewDescriptionStyleRule(),
+					Message: "Description should start with a capital letter.",
+					Range: hcl.Range{
+						Filename: "description_style_test.tf",
+						Start:    hcl.Pos{Line: 6, Column: 18},
+						End:      hcl.Pos{Line: 6, Column: 21},
+					},
+				},
+				{
+					Rule:    NewDescriptionStyleRule(),
+					Message: makeDescriptionShoutMessage("AWS"),
+					Range: hcl.Range{
+						Filename: "description_style_test.tf",
+						Start:    hcl.Pos{Line: 6, Column: 22},
+						End:      hcl.Pos{Line: 6, Column: 25},
+					},
+				},
+				{
+					Rule:    NewDescriptionStyleRule(),
+					Message: "Description should end with a period.",
+					Range: hcl.Range{
+						Filename: "description_style_test.tf",
+						Start:    hcl.Pos{Line: 11, Column: 54},
+						End:      hcl.Pos{Line: 11, Column: 58},
+					},
+				},
+				{
+					Rule:    NewDescriptionStyleRule(),
+					Message: "Description has trailing white space.",
+					Range: hcl.Range{
+						Filename: "description_style_test.tf",
+						Start:    hcl.Pos{Line: 16, Column: 60},
+						End:      hcl.Pos{Line: 16, Column: 61},
+					},
+				},
+				{
+					Rule:    NewDescriptionStyleRule(),
+					Message: makeDescriptionShoutMessage("ARN"),
+					Range: hcl.Range{
+						Filename: "description_style_test.tf",
+						Start:    hcl.Pos{Line: 22, Column: 9},
+						End:      hcl.Pos{Line: 22, Column: 12},
+					},
+				},
+				{
+					Rule:    NewDescriptionStyleRule(),
+					Message: "Description has trailing white space.",
+					Range: hcl.Range{
+						Filename: "description_style_test.tf",
+						Start:    hcl.Pos{Line: 22, Column: 31},
+						End:      hcl.Pos{Line: 22, Column: 32},
+					},
+				},
+				{
+					Rule:    NewDescriptionStyleRule(),
+					Message: makeDescriptionShoutMessage("IAM"),
+					Range: hcl.Range{
+						Filename: "description_style_test.tf",
+						Start:    hcl.Pos{Line: 23, Column: 37},
+						End:      hcl.Pos{Line: 23, Column: 40},
+					},
+				},
+				{
+					Rule:    NewDescriptionStyleRule(),
+					Message: "Description should end with a period.",
+					Range: hcl.Range{
+						Filename: "description_style_test.tf",
+						Start:    hcl.Pos{Line: 23, Column: 53},
+						End:      hcl.Pos{Line: 23, Column: 61},
+					},
+				},
+				{
+					Rule:    NewDescriptionStyleRule(),
+					Message: makeDescriptionShoutMessage("EC2"),
+					Range: hcl.Range{
+						Filename: "description_style_test.tf",
+						Start:    hcl.Pos{Line: 40, Column: 23},
+						End:      hcl.Pos{Line: 40, Column: 26},
+					},
+				},
+				{
+					Rule:    NewDescriptionStyleRule(),
+					Message: makeDescriptionShoutMessage("IAM"),
+					Range: hcl.Range{
+						Filename: "description_style_test.tf",
+						Start:    hcl.Pos{Line: 40, Column: 59},
+						End:      hcl.Pos{Line: 40, Column: 62},
+					},
+				},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+
+		// Run the tests and make sure the basic results are found...
+		files := map[string]string{"description_style_test.tf": tc.Content}
+		if tc.Config != "" {
+			files[".tflint.hcl"] = tc.Config
+		}
+		runner := helper.TestRunner(t, files)
+		rule := NewDescriptionStyleRule()
+
+		// ... no errors.
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		// ... and the expected number of issues.
+		if len(runner.Issues) != len(tc.Want) {
+			t.Logf("Expected %d issues, got %d", len(tc.Want), len(runner.Issues))
+			for i, issue := range runner.Issues {
+				t.Logf("Issue %d: %s at %s", i, issue.Message, issue.Range)
+			}
+			t.Fatalf("Number of issues mismatch: got %d, want %d", len(runner.Issues), len(tc.Want))
+		}
+
+		t.Run(tc.Name, func(t *testing.T) {
+			if *descriptionStyleDeep {
+				helper.AssertIssues(t, tc.Want, runner.Issues)
+			} else {
+				helper.AssertIssuesWithoutRange(t, tc.Want, runner.Issues)
+			}
+		})
+	}
+}
+
+func makeDescriptionOpenerMessage(opener string) string {
+	return fmt.Sprintf("Description should not start with '%s'.", opener)
+}
+
+func makeDescriptionShoutMessage(word string) string {
+	return fmt.Sprintf("'%s' should not be all uppercase.", word)
+}
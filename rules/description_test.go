@@ -0,0 +1,164 @@
+// Copyright (c) 2025 Steve Taranto <staranto@gmail.com>.
+// SPDX-License-Identifier: Apache-2.0
+
+package rules
+
+import (
+	"flag"
+	"fmt"
+	"testing"
+
+	"os"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+var descriptionDeep = flag.Bool("descriptionDeep", false, "enable deep assert")
+
+func TestDescriptionRule(t *testing.T) {
+	flag.Parse()
+
+	content, _ := os.ReadFile("testdata/description_test.tf")
+
+	missing := helper.Issues{
+		{
+			Rule:    NewDescriptionRule(),
+			Message: makeDescriptionMessage(`variable "region"`, "no description"),
+			Range: hcl.Range{
+				Filename: "description_test.tf",
+				Start:    hcl.Pos{Line: 4, Column: 1},
+				End:      hcl.Pos{Line: 4, Column: 18},
+			},
+		},
+		{
+			Rule:    NewDescriptionRule(),
+			Message: makeDescriptionMessage(`variable "zone"`, "an empty description"),
+			Range: hcl.Range{
+				Filename: "description_test.tf",
+				Start:    hcl.Pos{Line: 10, Column: 17},
+				End:      hcl.Pos{Line: 10, Column: 21},
+			},
+		},
+		{
+			Rule:    NewDescriptionRule(),
+			Message: makeDescriptionMessage(`variable "instance_type"`, "the placeholder description 'TBD'"),
+			Range: hcl.Range{
+				Filename: "description_test.tf",
+				Start:    hcl.Pos{Line: 15, Column: 17},
+				End:      hcl.Pos{Line: 15, Column: 22},
+			},
+		},
+	}
+	names := helper.Issues{
+		{
+			Rule:    NewDescriptionRule(),
+			Message: makeDescriptionMessage(`variable "vpc_id"`, "its own name as its description"),
+			Range: hcl.Range{
+				Filename: "description_test.tf",
+				Start:    hcl.Pos{Line: 20, Column: 17},
+				End:      hcl.Pos{Line: 20, Column: 26},
+			},
+		},
+	}
+	outputs := helper.Issues{
+		{
+			Rule:    NewDescriptionRule(),
+			Message: makeDescriptionMessage(`output "bucket_arn"`, "no description"),
+			Range: hcl.Range{
+				Filename: "description_test.tf",
+				Start:    hcl.Pos{Line: 23, Column: 1},
+				End:      hcl.Pos{Line: 23, Column: 20},
+			},
+		},
+		{
+			Rule:    NewDescriptionRule(),
+			Message: makeDescriptionMessage(`output "bucket_name"`, "the placeholder description 'todo.'"),
+			Range: hcl.Range{
+				Filename: "description_test.tf",
+				Start:    hcl.Pos{Line: 29, Column: 17},
+				End:      hcl.Pos{Line: 29, Column: 24},
+			},
+		},
+	}
+
+	cases := []struct {
+		Name    string
+		Config  string
+		Content string
+		Want    helper.Issues
+	}{
+		{
+			Name:    "description",
+			Content: string(content),
+			Want:    append(append(append(helper.Issues{}, missing...), names...), outputs...),
+		},
+		{
+			Name: "no_names",
+			Config: `
+rule "eos_description" {
+  enabled = true
+  names   = false
+}`,
+			Content: string(content),
+			Want:    append(append(helper.Issues{}, missing...), outputs...),
+		},
+		{
+			Name: "no_outputs",
+			Config: `
+rule "eos_description" {
+  enabled = true
+  outputs = false
+}`,
+			Content: string(content),
+			Want:    append(append(helper.Issues{}, missing...), names...),
+		},
+		{
+			Name: "skip_root_variables",
+			Config: `
+rule "eos_description" {
+  enabled             = true
+  skip_root_variables = true
+}`,
+			Content: string(content),
+			Want:    outputs,
+		},
+	}
+
+	for _, tc := range cases {
+
+		// Run the tests and make sure the basic results are found...
+		files := map[string]string{"description_test.tf": tc.Content}
+		if tc.Config != "" {
+			files[".tflint.hcl"] = tc.Config
+		}
+		runner := helper.TestRunner(t, files)
+		rule := NewDescriptionRule()
+
+		// ... no errors.
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		// ... and the expected number of issues.
+		if len(runner.Issues) != len(tc.Want) {
+			t.Logf("Expected %d issues, got %d", len(tc.Want), len(runner.Issues))
+			for i, issue := range runner.Issues {
+				t.Logf("Issue %d: %s at %s", i, issue.Message, issue.Range)
+			}
+			t.Fatalf("Number of issues mismatch: got %d, want %d", len(runner.Issues), len(tc.Want))
+		}
+
+		t.Run(tc.Name, func(t *testing.T) {
+			if *descriptionDeep {
+				helper.AssertIssues(t, tc.Want, runner.Issues)
+			} else {
+				helper.AssertIssuesWithoutRange(t, tc.Want, runner.Issues)
+			}
+		})
+	}
+}
+
+func makeDescriptionMessage(block string, problem string) string {
+	return fmt.Sprintf("%s has %s.", block, problem)
+}
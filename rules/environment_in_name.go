@@ -0,0 +1,198 @@
+// Copyright (c) 2025 Steve Taranto <staranto@gmail.com>.
+// SPDX-License-Identifier: Apache-2.0
+
+package rules
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/logger"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/zclconf/go-cty/cty"
+)
+
+var defaultEnvironmentInNameConfig = environmentInNameRuleConfig{
+	Environments: []string{"dev", "development", "prod", "production", "qa", "staging", "stg", "uat"},
+	Level:        "warning",
+}
+
+// environmentInNameRuleConfig represents the configuration for the
+// EnvironmentInNameRule.
+type environmentInNameRuleConfig struct {
+	Environments []string `hclext:"environments,optional"`
+	Literals     bool     `hclext:"literals,optional"`
+	Level        string   `hclext:"level,optional"`
+}
+
+// EnvironmentInNameRule checks whether names hard-code an environment.
+type EnvironmentInNameRule struct {
+	tflint.DefaultRule
+	Config environmentInNameRuleConfig
+
+	// names indexes the module's names for suggestions.
+	names nameIndex
+}
+
+// Check checks whether the rule conditions are met.
+func (r *EnvironmentInNameRule) Check(runner tflint.Runner) error {
+	if err := runner.DecodeRuleConfig(r.Name(), &r.Config); err != nil {
+		return err
+	}
+
+	names, err := indexNames(runner, allLintableBlocks)
+	if err != nil {
+		return err
+	}
+	r.names = names
+
+	if err := CheckBlocksAndLocals(runner, allLintableBlocks, r, checkForEnvironment); err != nil {
+		return err
+	}
+
+	if r.Config.Literals {
+		return r.checkLiterals(runner)
+	}
+	return nil
+}
+
+// environment returns the first environment among words, or "".
+func (r *EnvironmentInNameRule) environment(words []string) string {
+	for _, word := range words {
+		if slices.Contains(r.Config.Environments, word) {
+			return word
+		}
+	}
+	return ""
+}
+
+// checkForEnvironment checks if the name contains an environment.
+func checkForEnvironment(runner tflint.Runner, r *EnvironmentInNameRule, block *hclext.Block, typ string, name string, _ string) {
+	environment := r.environment(tokenizeName(name))
+	if environment == "" {
+		return
+	}
+
+	message := fmt.Sprintf("'%s' hard-codes the environment '%s'.", name, environment)
+	message = withSuggestion(message, suggestName(name, nameKind(block, typ), r.names, func(w string) string {
+		if slices.Contains(r.Config.Environments, w) {
+			return ""
+		}
+		return w
+	}))
+	if err := runner.EmitIssue(r, message, block.DefRange); err != nil {
+		logger.Error(err.Error())
+	}
+	logger.Debug(message)
+}
+
+// checkLiterals checks the string literals of the name and tags.Name
+// attributes of resources.
+func (r *EnvironmentInNameRule) checkLiterals(runner tflint.Runner) error {
+	content, err := runner.GetModuleContent(&hclext.BodySchema{
+		Blocks: []hclext.BlockSchema{
+			{
+				Type:       "resource",
+				LabelNames: []string{"type", "name"},
+				Body: &hclext.BodySchema{
+					Attributes: []hclext.AttributeSchema{{Name: "name"}, {Name: "tags"}},
+				},
+			},
+		},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, block := range content.Blocks {
+		if attr, ok := block.Body.Attributes["name"]; ok {
+			r.checkLiteral(runner, "name", attr.Expr)
+		}
+
+		attr, ok := block.Body.Attributes["tags"]
+		if !ok {
+			continue
+		}
+		tags, ok := attr.Expr.(*hclsyntax.ObjectConsExpr)
+		if !ok {
+			continue
+		}
+		for _, item := range tags.Items {
+			if key, _ := item.KeyExpr.Value(nil); key.Type() == cty.String && key.IsKnown() && !key.IsNull() && key.AsString() == "Name" {
+				r.checkLiteral(runner, "tags.Name", item.ValueExpr)
+			}
+		}
+	}
+
+	return nil
+}
+
+// checkLiteral checks the literal parts of a string expression. Interpolated
+// parts are not literals, so "${var.env}-db" is fine.
+func (r *EnvironmentInNameRule) checkLiteral(runner tflint.Runner, attribute string, expr hcl.Expression) {
+	var parts []hclsyntax.Expression
+	switch e := expr.(type) {
+	case *hclsyntax.TemplateExpr:
+		parts = e.Parts
+	case *hclsyntax.LiteralValueExpr:
+		parts = []hclsyntax.Expression{e}
+	}
+
+	for _, part := range parts {
+		literal, ok := part.(*hclsyntax.LiteralValueExpr)
+		if !ok || literal.Val.Type() != cty.String {
+			continue
+		}
+		text := literal.Val.AsString()
+
+		var words []string
+		for _, field := range strings.FieldsFunc(text, func(c rune) bool {
+			return !unicode.IsLetter(c) && !unicode.IsDigit(c)
+		}) {
+			words = append(words, tokenizeName(field)...)
+		}
+
+		environment := r.environment(words)
+		if environment == "" {
+			continue
+		}
+
+		message := fmt.Sprintf("The %s \"%s\" hard-codes the environment '%s'.", attribute, text, environment)
+		if err := runner.EmitIssue(r, message, literal.Range()); err != nil {
+			logger.Error(err.Error())
+		}
+		logger.Debug(message)
+	}
+}
+
+// NewEnvironmentInNameRule returns a new rule.
+func NewEnvironmentInNameRule() *EnvironmentInNameRule {
+	rule := &EnvironmentInNameRule{}
+	rule.Config = defaultEnvironmentInNameConfig
+	return rule
+}
+
+// Enabled returns whether the rule is enabled by default.
+func (r *EnvironmentInNameRule) Enabled() bool {
+	return true
+}
+
+// Link returns the rule reference link.
+func (r *EnvironmentInNameRule) Link() string {
+	return "https://github.com/staranto/tflint-ruleset-elements-of-style/blob/main/docs/rules/eos_environment_in_name.md"
+}
+
+// Name returns the rule name.
+func (r *EnvironmentInNameRule) Name() string {
+	return "eos_environment_in_name"
+}
+
+// Severity returns the rule severity.
+func (r *EnvironmentInNameRule) Severity() tflint.Severity {
+	return toSeverity(r.Config.Level)
+}
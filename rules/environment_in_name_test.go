@@ -0,0 +1,145 @@
+// Copyright (c) 2025 Steve Taranto <staranto@gmail.com>.
+// SPDX-License-Identifier: Apache-2.0
+
+package rules
+
+import (
+	"flag"
+	"fmt"
+	"testing"
+
+	"os"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+var environmentInNameDeep = flag.Bool("environmentInNameDeep", false, "enable deep assert")
+
+func TestEnvironmentInNameRule(t *testing.T) {
+	flag.Parse()
+
+	content, _ := os.ReadFile("testdata/environment_in_name_test.tf")
+
+	names := helper.Issues{
+		{
+			Rule:    NewEnvironmentInNameRule(),
+			Message: makeEnvironmentMessage("prod_db", "prod", "db"),
+			Range: hcl.Range{
+				Filename: "environment_in_name_test.tf",
+				Start:    hcl.Pos{Line: 4, Column: 1},
+				End:      hcl.Pos{Line: 4, Column: 37},
+			},
+		},
+		{
+			Rule:    NewEnvironmentInNameRule(),
+			Message: makeEnvironmentMessage("bucket_staging", "staging", "bucket"),
+			Range: hcl.Range{
+				Filename: "environment_in_name_test.tf",
+				Start:    hcl.Pos{Line: 8, Column: 1},
+				End:      hcl.Pos{Line: 8, Column: 42},
+			},
+		},
+		{
+			Rule:    NewEnvironmentInNameRule(),
+			Message: makeEnvironmentMessage("dev_cidr", "dev", "cidr"),
+			Range: hcl.Range{
+				Filename: "environment_in_name_test.tf",
+				Start:    hcl.Pos{Line: 13, Column: 3},
+				End:      hcl.Pos{Line: 13, Column: 27},
+			},
+		},
+		{
+			Rule:    NewEnvironmentInNameRule(),
+			Message: makeEnvironmentMessage("uat_network", "uat", "network"),
+			Range: hcl.Range{
+				Filename: "environment_in_name_test.tf",
+				Start:    hcl.Pos{Line: 16, Column: 1},
+				End:      hcl.Pos{Line: 16, Column: 21},
+			},
+		},
+	}
+
+	cases := []struct {
+		Name    string
+		Config  string
+		Content string
+		Want    helper.Issues
+	}{
+		{
+			Name:    "names",
+			Content: string(content),
+			Want:    names,
+		},
+		{
+			Name: "literals",
+			Config: `
+rule "eos_environment_in_name" {
+  enabled  = true
+  literals = true
+}`,
+			Content: string(content),
+			Want: append(helper.Issues{
+				{
+					Rule:    NewEnvironmentInNameRule(),
+					Message: makeLiteralEnvironmentMessage("name", "deployer-prod", "prod"),
+					Range: hcl.Range{
+						Filename: "environment_in_name_test.tf",
+						Start:    hcl.Pos{Line: 21, Column: 11},
+						End:      hcl.Pos{Line: 21, Column: 24},
+					},
+				},
+				{
+					Rule:    NewEnvironmentInNameRule(),
+					Message: makeLiteralEnvironmentMessage("tags.Name", "-production-deployer", "production"),
+					Range: hcl.Range{
+						Filename: "environment_in_name_test.tf",
+						Start:    hcl.Pos{Line: 23, Column: 24},
+						End:      hcl.Pos{Line: 23, Column: 44},
+					},
+				},
+			}, names...),
+		},
+	}
+
+	for _, tc := range cases {
+
+		// Run the tests and make sure the basic results are found...
+		files := map[string]string{"environment_in_name_test.tf": tc.Content}
+		if tc.Config != "" {
+			files[".tflint.hcl"] = tc.Config
+		}
+		runner := helper.TestRunner(t, files)
+		rule := NewEnvironmentInNameRule()
+
+		// ... no errors.
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		// ... and the expected number of issues.
+		if len(runner.Issues) != len(tc.Want) {
+			t.Logf("Expected %d issues, got %d", len(tc.Want), len(runner.Issues))
+			for i, issue := range runner.Issues {
+				t.Logf("Issue %d: %s at %s", i, issue.Message, issue.Range)
+			}
+			t.Fatalf("Number of issues mismatch: got %d, want %d", len(runner.Issues), len(tc.Want))
+		}
+
+		t.Run(tc.Name, func(t *testing.T) {
+			if *environmentInNameDeep {
+				helper.AssertIssues(t, tc.Want, runner.Issues)
+			} else {
+				helper.AssertIssuesWithoutRange(t, tc.Want, runner.Issues)
+			}
+		})
+	}
+}
+
+func makeEnvironmentMessage(name string, environment string, suggestion string) string {
+	return fmt.Sprintf("'%s' hard-codes the environment '%s'. Consider '%s'.", name, environment, suggestion)
+}
+
+func makeLiteralEnvironmentMessage(attribute string, text string, environment string) string {
+	return fmt.Sprintf("The %s \"%s\" hard-codes the environment '%s'.", attribute, text, environment)
+}
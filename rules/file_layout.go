@@ -0,0 +1,146 @@
+// Copyright (c) 2025 Steve Taranto <staranto@gmail.com>.
+// SPDX-License-Identifier: Apache-2.0
+
+package rules
+
+import (
+	"fmt"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/logger"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// topLevelBlocks maps the top-level block types to their label names.
+var topLevelBlocks = map[string][]string{
+	"check":     {"name"},
+	"data":      {"type", "name"},
+	"ephemeral": {"type", "name"},
+	"import":    {},
+	"locals":    {},
+	"module":    {"name"},
+	"moved":     {},
+	"output":    {"name"},
+	"provider":  {"name"},
+	"removed":   {},
+	"resource":  {"type", "name"},
+	"terraform": {},
+	"variable":  {"name"},
+}
+
+var defaultFileLayoutConfig = fileLayoutRuleConfig{
+	Layout: map[string][]string{
+		"output":    {"outputs.tf"},
+		"provider":  {"providers.tf"},
+		"terraform": {"versions.tf", "terraform.tf"},
+		"variable":  {"variables.tf"},
+	},
+	Level: "warning",
+}
+
+// fileLayoutRuleConfig represents the configuration for the FileLayoutRule.
+type fileLayoutRuleConfig struct {
+	Layout map[string][]string `hclext:"layout,optional"`
+	Level  string              `hclext:"level,optional"`
+}
+
+// FileLayoutRule checks whether blocks are in their conventional files.
+type FileLayoutRule struct {
+	tflint.DefaultRule
+	Config fileLayoutRuleConfig
+}
+
+// Check checks whether the rule conditions are met.
+func (r *FileLayoutRule) Check(runner tflint.Runner) error {
+	if err := runner.DecodeRuleConfig(r.Name(), &r.Config); err != nil {
+		return err
+	}
+
+	schema := &hclext.BodySchema{}
+	for typ, filenames := range r.Config.Layout {
+		labels, ok := topLevelBlocks[typ]
+		if !ok {
+			return fmt.Errorf("unknown block type '%s'", typ)
+		}
+		if len(filenames) == 0 {
+			return fmt.Errorf("no files for block type '%s'", typ)
+		}
+		schema.Blocks = append(schema.Blocks, hclext.BlockSchema{Type: typ, LabelNames: labels})
+	}
+
+	files, err := runner.GetFiles()
+	if err != nil {
+		return err
+	}
+	existing := map[string]bool{}
+	for name := range files {
+		existing[filepath.Base(name)] = true
+	}
+
+	content, err := runner.GetModuleContent(schema, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, block := range content.Blocks {
+		allowed := r.Config.Layout[block.Type]
+		filename := filepath.Base(block.DefRange.Filename)
+		if isOverrideFile(filename) || slices.Contains(allowed, strings.TrimSuffix(filename, ".json")) {
+			continue
+		}
+
+		// Point at the allowed file the module already has, if any.
+		want := allowed[0]
+		for _, name := range allowed {
+			if existing[name] || existing[name+".json"] {
+				want = name
+				break
+			}
+		}
+
+		message := fmt.Sprintf("%s belongs in %s, not %s.", describeBlock(block), want, filename)
+		if err := runner.EmitIssue(r, message, block.DefRange); err != nil {
+			logger.Error(err.Error())
+		}
+		logger.Debug(message)
+	}
+
+	return nil
+}
+
+// isOverrideFile reports whether a file is a Terraform override file, which
+// has to sit next to the file it overrides.
+func isOverrideFile(filename string) bool {
+	name := strings.TrimSuffix(strings.TrimSuffix(filename, ".json"), ".tf")
+	return name == "override" || strings.HasSuffix(name, "_override")
+}
+
+// NewFileLayoutRule returns a new rule.
+func NewFileLayoutRule() *FileLayoutRule {
+	rule := &FileLayoutRule{}
+	rule.Config = defaultFileLayoutConfig
+	return rule
+}
+
+// Enabled returns whether the rule is enabled by default.
+func (r *FileLayoutRule) Enabled() bool {
+	return true
+}
+
+// Link returns the rule reference link.
+func (r *FileLayoutRule) Link() string {
+	return "https://github.com/staranto/tflint-ruleset-elements-of-style/blob/main/docs/rules/eos_file_layout.md"
+}
+
+// Name returns the rule name.
+func (r *FileLayoutRule) Name() string {
+	return "eos_file_layout"
+}
+
+// Severity returns the rule severity.
+func (r *FileLayoutRule) Severity() tflint.Severity {
+	return toSeverity(r.Config.Level)
+}
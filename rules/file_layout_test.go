@@ -0,0 +1,143 @@
+// Copyright (c) 2025 Steve Taranto <staranto@gmail.com>.
+// SPDX-License-Identifier: Apache-2.0
+
+package rules
+
+import (
+	"flag"
+	"fmt"
+	"testing"
+
+	"os"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+var fileLayoutDeep = flag.Bool("fileLayoutDeep", false, "enable deep assert")
+
+func TestFileLayoutRule(t *testing.T) {
+	flag.Parse()
+
+	content, _ := os.ReadFile("testdata/file_layout_test.tf")
+
+	// The module's other files, which are all in place.
+	others := map[string]string{
+		"terraform.tf":     "terraform {}\n",
+		"variables.tf":     "variable \"name\" {}\n",
+		"main_override.tf": "variable \"region\" {\n  default = \"us-west-2\"\n}\n",
+	}
+
+	cases := []struct {
+		Name    string
+		Config  string
+		Content string
+		Want    helper.Issues
+	}{
+		{
+			Name:    "file_layout",
+			Content: string(content),
+			Want: helper.Issues{
+				{
+					Rule:    NewFileLayoutRule(),
+					Message: makeFileLayoutMessage("terraform", "terraform.tf", "main.tf"),
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 4, Column: 1},
+						End:      hcl.Pos{Line: 4, Column: 10},
+					},
+				},
+				{
+					Rule:    NewFileLayoutRule(),
+					Message: makeFileLayoutMessage(`provider "aws"`, "providers.tf", "main.tf"),
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 8, Column: 1},
+						End:      hcl.Pos{Line: 8, Column: 15},
+					},
+				},
+				{
+					Rule:    NewFileLayoutRule(),
+					Message: makeFileLayoutMessage(`variable "region"`, "variables.tf", "main.tf"),
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 12, Column: 1},
+						End:      hcl.Pos{Line: 12, Column: 18},
+					},
+				},
+				{
+					Rule:    NewFileLayoutRule(),
+					Message: makeFileLayoutMessage(`output "bucket_arn"`, "outputs.tf", "main.tf"),
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 14, Column: 1},
+						End:      hcl.Pos{Line: 14, Column: 20},
+					},
+				},
+			},
+		},
+		{
+			Name: "layout",
+			Config: `
+rule "eos_file_layout" {
+  enabled = true
+  layout  = {
+    locals   = ["locals.tf"]
+    resource = ["main.tf"]
+  }
+}`,
+			Content: string(content),
+			Want: helper.Issues{
+				{
+					Rule:    NewFileLayoutRule(),
+					Message: makeFileLayoutMessage("locals", "locals.tf", "main.tf"),
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 25, Column: 1},
+						End:      hcl.Pos{Line: 25, Column: 7},
+					},
+				},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+
+		// Run the tests and make sure the basic results are found...
+		files := map[string]string{"main.tf": tc.Content}
+		for name, other := range others {
+			files[name] = other
+		}
+		if tc.Config != "" {
+			files[".tflint.hcl"] = tc.Config
+		}
+		runner := helper.TestRunner(t, files)
+		rule := NewFileLayoutRule()
+
+		// ... no errors.
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		// ... and the expected number of issues.
+		if len(runner.Issues) != len(tc.Want) {
+			t.Logf("Expected %d issues, got %d", len(tc.Want), len(runner.Issues))
+			for i, issue := range runner.Issues {
+				t.Logf("Issue %d: %s at %s", i, issue.Message, issue.Range)
+			}
+			t.Fatalf("Number of issues mismatch: got %d, want %d", len(runner.Issues), len(tc.Want))
+		}
+
+		t.Run(tc.Name, func(t *testing.T) {
+			if *fileLayoutDeep {
+				helper.AssertIssues(t, tc.Want, runner.Issues)
+			} else {
+				helper.AssertIssuesWithoutRange(t, tc.Want, runner.Issues)
+			}
+		})
+	}
+}
+
+func makeFileLayoutMessage(block string, want string, got string) string {
+	return fmt.Sprintf("%s belongs in %s, not %s.", block, want, got)
+}
@@ -0,0 +1,115 @@
+// Copyright (c) 2025 Steve Taranto <staranto@gmail.com>.
+// SPDX-License-Identifier: Apache-2.0
+
+package rules
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/logger"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+var defaultGenericNames = []string{
+	"bar", "baz", "foo",
+	"default", "main", "this",
+	"example", "sample", "test",
+	"temp", "tmp",
+}
+
+var defaultGenericSingletons = []string{
+	"main", "this",
+}
+
+var defaultGenericNamesConfig = genericNamesRuleConfig{
+	Names:      defaultGenericNames,
+	Singletons: defaultGenericSingletons,
+	Level:      "warning",
+}
+
+// genericNamesRuleConfig represents the configuration for the
+// GenericNamesRule.
+type genericNamesRuleConfig struct {
+	Names      []string `hclext:"names,optional"`
+	Singletons []string `hclext:"singletons,optional"`
+	Level      string   `hclext:"level,optional"`
+}
+
+// GenericNamesRule checks whether a block's name is a placeholder.
+type GenericNamesRule struct {
+	tflint.DefaultRule
+	Config genericNamesRuleConfig
+
+	// names indexes the module's names to count the instances of each type.
+	names nameIndex
+}
+
+// Check checks whether the rule conditions are met.
+func (r *GenericNamesRule) Check(runner tflint.Runner) error {
+	if err := runner.DecodeRuleConfig(r.Name(), &r.Config); err != nil {
+		return err
+	}
+
+	names, err := indexNames(runner, allLintableBlocks)
+	if err != nil {
+		return err
+	}
+	r.names = names
+
+	return CheckBlocksAndLocals(runner, allLintableBlocks, r, checkForGeneric)
+}
+
+// checkForGeneric checks if the name is a placeholder. Singleton names are
+// allowed when the block is the only one of its type in the module.
+func checkForGeneric(runner tflint.Runner, r *GenericNamesRule, block *hclext.Block, typ string, name string, _ string) {
+	lowerName := strings.ToLower(name)
+	if !slices.Contains(r.Config.Names, lowerName) {
+		return
+	}
+
+	var message string
+	count := len(r.names[nameKind(block, typ)])
+	switch {
+	case !slices.Contains(r.Config.Singletons, lowerName):
+		message = fmt.Sprintf("'%s' is a generic name that says nothing about its purpose.", name)
+	case count > 1:
+		message = fmt.Sprintf("'%s' is only conventional for the sole '%s' in a module, but there are %d.", name, typ, count)
+	default:
+		return
+	}
+
+	if err := runner.EmitIssue(r, message, block.DefRange); err != nil {
+		logger.Error(err.Error())
+	}
+	logger.Debug(message)
+}
+
+// NewGenericNamesRule returns a new rule.
+func NewGenericNamesRule() *GenericNamesRule {
+	rule := &GenericNamesRule{}
+	rule.Config = defaultGenericNamesConfig
+	return rule
+}
+
+// Enabled returns whether the rule is enabled by default.
+func (r *GenericNamesRule) Enabled() bool {
+	return true
+}
+
+// Link returns the rule reference link.
+func (r *GenericNamesRule) Link() string {
+	return "https://github.com/staranto/tflint-ruleset-elements-of-style/blob/main/docs/rules/eos_generic_names.md"
+}
+
+// Name returns the rule name.
+func (r *GenericNamesRule) Name() string {
+	return "eos_generic_names"
+}
+
+// Severity returns the rule severity.
+func (r *GenericNamesRule) Severity() tflint.Severity {
+	return toSeverity(r.Config.Level)
+}
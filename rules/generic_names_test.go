@@ -0,0 +1,110 @@
+// Copyright (c) 2025 Steve Taranto <staranto@gmail.com>.
+// SPDX-License-Identifier: Apache-2.0
+
+package rules
+
+import (
+	"flag"
+	"fmt"
+	"testing"
+
+	"os"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+var genericNamesDeep = flag.Bool("genericNamesDeep", false, "enable deep assert")
+
+func TestGenericNamesRule(t *testing.T) {
+	flag.Parse()
+
+	cases := []struct {
+		Name    string
+		Content string
+		Want    helper.Issues
+	}{
+		{
+			Name: "generic_names",
+			Content: func() string {
+				content, _ := os.ReadFile("testdata/generic_names_test.tf")
+				return string(content)
+			}(),
+			Want: helper.Issues{
+				{
+					Rule:    NewGenericNamesRule(),
+					Message: makeGenericNameMessage("foo"),
+					Range: hcl.Range{
+						Filename: "generic_names_test.tf",
+						Start:    hcl.Pos{Line: 4, Column: 1},
+						End:      hcl.Pos{Line: 4, Column: 15},
+					},
+				},
+				{
+					Rule:    NewGenericNamesRule(),
+					Message: makeGenericNameMessage("temp"),
+					Range: hcl.Range{
+						Filename: "generic_names_test.tf",
+						Start:    hcl.Pos{Line: 7, Column: 3},
+						End:      hcl.Pos{Line: 7, Column: 11},
+					},
+				},
+				{
+					Rule:    NewGenericNamesRule(),
+					Message: makeGenericSingletonMessage("this", "aws_subnet", 2),
+					Range: hcl.Range{
+						Filename: "generic_names_test.tf",
+						Start:    hcl.Pos{Line: 10, Column: 1},
+						End:      hcl.Pos{Line: 10, Column: 29},
+					},
+				},
+				{
+					Rule:    NewGenericNamesRule(),
+					Message: makeGenericNameMessage("example"),
+					Range: hcl.Range{
+						Filename: "generic_names_test.tf",
+						Start:    hcl.Pos{Line: 18, Column: 1},
+						End:      hcl.Pos{Line: 18, Column: 17},
+					},
+				},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+
+		// Run the tests and make sure the basic results are found...
+		runner := helper.TestRunner(t, map[string]string{"generic_names_test.tf": tc.Content})
+		rule := NewGenericNamesRule()
+
+		// ... no errors.
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		// ... and the expected number of issues.
+		if len(runner.Issues) != len(tc.Want) {
+			t.Logf("Expected %d issues, got %d", len(tc.Want), len(runner.Issues))
+			for i, issue := range runner.Issues {
+				t.Logf("Issue %d: %s at %s", i, issue.Message, issue.Range)
+			}
+			t.Fatalf("Number of issues mismatch: got %d, want %d", len(runner.Issues), len(tc.Want))
+		}
+
+		t.Run(tc.Name, func(t *testing.T) {
+			if *genericNamesDeep {
+				helper.AssertIssues(t, tc.Want, runner.Issues)
+			} else {
+				helper.AssertIssuesWithoutRange(t, tc.Want, runner.Issues)
+			}
+		})
+	}
+}
+
+func makeGenericNameMessage(name string) string {
+	return fmt.Sprintf("'%s' is a generic name that says nothing about its purpose.", name)
+}
+
+func makeGenericSingletonMessage(name string, typ string, count int) string {
+	return fmt.Sprintf("'%s' is only conventional for the sole '%s' in a module, but there are %d.", name, typ, count)
+}
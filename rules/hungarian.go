@@ -5,6 +5,7 @@ package rules
 
 import (
 	"fmt"
+	"slices"
 	"strings"
 
 	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
@@ -34,6 +35,9 @@ type hungarianRuleConfig struct {
 type HungarianRule struct {
 	tflint.DefaultRule
 	Config hungarianRuleConfig
+
+	// names indexes the module's names for suggestions.
+	names nameIndex
 }
 
 // Check checks whether the rule conditions are met.
@@ -42,6 +46,12 @@ func (r *HungarianRule) Check(runner tflint.Runner) error {
 		return err
 	}
 
+	names, err := indexNames(runner, allLintableBlocks)
+	if err != nil {
+		return err
+	}
+	r.names = names
+
 	return CheckBlocksAndLocals(runner, allLintableBlocks, r, checkForHungarian)
 }
 
@@ -51,8 +61,9 @@ func checkForHungarian(runner tflint.Runner, r *HungarianRule, block *hclext.Blo
 
 	for _, t := range tags {
 		if strings.HasPrefix(name, t) || strings.HasSuffix(name, t) || strings.Contains(name, "_"+t) {
-			if err := runner.EmitIssue(r, fmt.Sprintf("'%s' uses Hungarian notation with '%s'.", name, t),
-				block.DefRange); err != nil {
+			message := withSuggestion(fmt.Sprintf("'%s' uses Hungarian notation with '%s'.", name, t),
+				suggestName(name, nameKind(block, typ), r.names, stripHungarian(name, t, tags)))
+			if err := runner.EmitIssue(r, message, block.DefRange); err != nil {
 				logger.Error(err.Error())
 			}
 			return
@@ -60,6 +71,32 @@ func checkForHungarian(runner tflint.Runner, r *HungarianRule, block *hclext.Blo
 	}
 }
 
+// stripHungarian returns a rewrite func for suggestName that drops words that
+// are tags. When the matched tag is glued to the name ("strname") rather than
+// a word of its own, it is trimmed from the first or last word instead.
+func stripHungarian(name string, matched string, tags []string) func(string) string {
+	words := tokenizeName(name)
+	if len(words) == 0 {
+		return keepWord
+	}
+
+	glued := !slices.Contains(words, matched)
+	first, last := words[0], words[len(words)-1]
+
+	return func(word string) string {
+		if slices.Contains(tags, word) {
+			return ""
+		}
+		if glued && word == first {
+			word = strings.TrimPrefix(word, matched)
+		}
+		if glued && word == last {
+			word = strings.TrimSuffix(word, matched)
+		}
+		return word
+	}
+}
+
 // NewHungarianRule returns a new rule.
 func NewHungarianRule() *HungarianRule {
 	rule := &HungarianRule{}
@@ -33,7 +33,7 @@ func TestHungarianRule(t *testing.T) {
 			Want: helper.Issues{
 				{
 					Rule:    NewHungarianRule(),
-					Message: makeHungarianMessage("str_hung", "str"),
+					Message: makeHungarianMessage("str_hung", "str", "hung"),
 					Range: hcl.Range{
 						Filename: "hungarian_test.tf",
 						Start:    hcl.Pos{Line: 7, Column: 1},
@@ -42,7 +42,7 @@ func TestHungarianRule(t *testing.T) {
 				},
 				{
 					Rule:    NewHungarianRule(),
-					Message: makeHungarianMessage("hung_int", "int"),
+					Message: makeHungarianMessage("hung_int", "int", "hung"),
 					Range: hcl.Range{
 						Filename: "hungarian_test.tf",
 						Start:    hcl.Pos{Line: 10, Column: 3},
@@ -51,7 +51,7 @@ func TestHungarianRule(t *testing.T) {
 				},
 				{
 					Rule:    NewHungarianRule(),
-					Message: makeHungarianMessage("hung_bool_check", "bool"),
+					Message: makeHungarianMessage("hung_bool_check", "bool", "hung_check"),
 					Range: hcl.Range{
 						Filename: "hungarian_test.tf",
 						Start:    hcl.Pos{Line: 13, Column: 1},
@@ -60,7 +60,7 @@ func TestHungarianRule(t *testing.T) {
 				},
 				{
 					Rule:    NewHungarianRule(),
-					Message: makeHungarianMessage("map_hung", "map"),
+					Message: makeHungarianMessage("map_hung", "map", "hung"),
 					Range: hcl.Range{
 						Filename: "hungarian_test.tf",
 						Start:    hcl.Pos{Line: 20, Column: 1},
@@ -69,7 +69,7 @@ func TestHungarianRule(t *testing.T) {
 				},
 				{
 					Rule:    NewHungarianRule(),
-					Message: makeHungarianMessage("hung_lst", "lst"),
+					Message: makeHungarianMessage("hung_lst", "lst", "hung"),
 					Range: hcl.Range{
 						Filename: "hungarian_test.tf",
 						Start:    hcl.Pos{Line: 22, Column: 1},
@@ -78,7 +78,7 @@ func TestHungarianRule(t *testing.T) {
 				},
 				{
 					Rule:    NewHungarianRule(),
-					Message: makeHungarianMessage("hung_set_mod", "set"),
+					Message: makeHungarianMessage("hung_set_mod", "set", "hung_mod"),
 					Range: hcl.Range{
 						Filename: "hungarian_test.tf",
 						Start:    hcl.Pos{Line: 26, Column: 1},
@@ -87,7 +87,7 @@ func TestHungarianRule(t *testing.T) {
 				},
 				{
 					Rule:    NewHungarianRule(),
-					Message: makeHungarianMessage("num_hung", "num"),
+					Message: makeHungarianMessage("num_hung", "num", "hung"),
 					Range: hcl.Range{
 						Filename: "hungarian_test.tf",
 						Start:    hcl.Pos{Line: 30, Column: 1},
@@ -96,7 +96,7 @@ func TestHungarianRule(t *testing.T) {
 				},
 				{
 					Rule:    NewHungarianRule(),
-					Message: makeHungarianMessage("str_hung", "str"),
+					Message: makeHungarianMessage("str_hung", "str", "hung"),
 					Range: hcl.Range{
 						Filename: "hungarian_test.tf",
 						Start:    hcl.Pos{Line: 35, Column: 1},
@@ -133,6 +133,6 @@ func TestHungarianRule(t *testing.T) {
 	}
 }
 
-func makeHungarianMessage(name string, key string) string {
-	return fmt.Sprintf("'%s' uses Hungarian notation with '%s'.", name, key)
+func makeHungarianMessage(name string, key string, suggestion string) string {
+	return fmt.Sprintf("'%s' uses Hungarian notation with '%s'. Consider '%s'.", name, key, suggestion)
 }
@@ -5,7 +5,11 @@ package rules
 
 import (
 	"fmt"
+	"regexp"
+	"slices"
 
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
 	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
 	"github.com/terraform-linters/tflint-plugin-sdk/logger"
 	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
@@ -14,15 +18,28 @@ import (
 // defaultLength is the default maximum length for names.
 const defaultLength = 16
 
+// shortNameParser matches meaningless names like "a", "x" or "r1".
+var shortNameParser = regexp.MustCompile(`^[A-Za-z][0-9]*$`)
+
+var defaultLengthAllow = []string{
+	"az", "db", "id", "ip",
+	"k", "s3", "v",
+}
+
 var defaultLengthConfig = lengthRuleConfig{
+	Allow:  defaultLengthAllow,
 	Length: defaultLength,
 	Level:  "warning",
+	Short:  true,
 }
 
 // lengthRuleConfig represents the configuration for the LengthRule.
 type lengthRuleConfig struct {
-	Length int    `hclext:"length,optional"`
-	Level  string `hclext:"level,optional"`
+	Allow     []string `hclext:"allow,optional"`
+	Length    int      `hclext:"length,optional"`
+	Level     string   `hclext:"level,optional"`
+	MinLength int      `hclext:"min_length,optional"`
+	Short     bool     `hclext:"short,optional"`
 }
 
 // LengthRule checks whether a block's name is excessively long.
@@ -38,10 +55,14 @@ func (rule *LengthRule) Check(runner tflint.Runner) error {
 	}
 	logger.Debug(fmt.Sprintf("rule.Config=%v", rule.Config))
 
-	return CheckBlocksAndLocals(runner, allLintableBlocks, rule, checkForLength)
+	if err := CheckBlocksAndLocals(runner, allLintableBlocks, rule, checkForLength); err != nil {
+		return err
+	}
+
+	return rule.checkIterators(runner)
 }
 
-// checkForLength checks if the name is too long.
+// checkForLength checks if the name is too long or too short.
 func checkForLength(runner tflint.Runner, r *LengthRule, block *hclext.Block, _ string, name string, _ string) {
 	limit := r.Config.Length
 
@@ -52,6 +73,86 @@ func checkForLength(runner tflint.Runner, r *LengthRule, block *hclext.Block, _
 		}
 		logger.Debug(message)
 	}
+
+	checkForShortness(runner, r, name, block.DefRange)
+}
+
+// checkForShortness checks if the name is too short to be meaningful.
+func checkForShortness(runner tflint.Runner, r *LengthRule, name string, rng hcl.Range) {
+	if name == "" || slices.Contains(r.Config.Allow, name) {
+		return
+	}
+
+	var message string
+	switch {
+	case r.Config.Short && shortNameParser.MatchString(name):
+		message = fmt.Sprintf("'%s' is too short to be meaningful.", name)
+	case len(name) < r.Config.MinLength:
+		message = fmt.Sprintf("'%s' is %d characters and should not be shorter than %d.", name, len(name), r.Config.MinLength)
+	default:
+		return
+	}
+
+	if err := runner.EmitIssue(r, message, rng); err != nil {
+		logger.Error(err.Error())
+	}
+	logger.Debug(message)
+}
+
+// checkIterators checks the names of for expression variables and dynamic
+// block iterators. Neither is a block or a local, so CheckBlocksAndLocals
+// doesn't see them.
+func (rule *LengthRule) checkIterators(runner tflint.Runner) error {
+	diags := runner.WalkExpressions(tflint.ExprWalkFunc(func(expr hcl.Expression) hcl.Diagnostics {
+		forExpr, ok := expr.(*hclsyntax.ForExpr)
+		if !ok {
+			return nil
+		}
+
+		file, err := runner.GetFile(forExpr.SrcRange.Filename)
+		if err != nil || file == nil {
+			return nil
+		}
+
+		// The variables have no ranges of their own, so find them among the
+		// tokens between the opening bracket and the collection.
+		src := file.Bytes[forExpr.OpenRange.End.Byte:forExpr.CollExpr.Range().Start.Byte]
+		tokens, _ := hclsyntax.LexExpression(src, forExpr.SrcRange.Filename, forExpr.OpenRange.End)
+		for _, token := range tokens {
+			name := string(token.Bytes)
+			if token.Type == hclsyntax.TokenIdent && (name == forExpr.KeyVar || name == forExpr.ValVar) {
+				checkForShortness(runner, rule, name, token.Range)
+			}
+		}
+		return nil
+	}))
+	if diags.HasErrors() {
+		return diags
+	}
+
+	files, err := runner.GetFiles()
+	if err != nil {
+		return err
+	}
+	for _, file := range files {
+		if body, ok := file.Body.(*hclsyntax.Body); ok {
+			rule.checkDynamicIterators(runner, body)
+		}
+	}
+
+	return nil
+}
+
+// checkDynamicIterators recursively checks the iterator names of dynamic
+// blocks in the body.
+func (rule *LengthRule) checkDynamicIterators(runner tflint.Runner, body *hclsyntax.Body) {
+	for _, block := range body.Blocks {
+		if attr, ok := block.Body.Attributes["iterator"]; ok && block.Type == "dynamic" {
+			name := hcl.ExprAsKeyword(attr.Expr)
+			checkForShortness(runner, rule, name, attr.Expr.Range())
+		}
+		rule.checkDynamicIterators(runner, block.Body)
+	}
 }
 
 // NewLengthRule returns a new rule.
@@ -22,6 +22,7 @@ func TestLengthRule(t *testing.T) {
 
 	cases := []struct {
 		Name    string
+		Config  string
 		Content string
 		Want    helper.Issues
 	}{
@@ -106,12 +107,75 @@ func TestLengthRule(t *testing.T) {
 				},
 			},
 		},
+		{
+			Name: "short_names",
+			Config: `
+rule "eos_length" {
+  enabled    = true
+  min_length = 3
+}`,
+			Content: func() string {
+				content, _ := os.ReadFile("testdata/length_short_test.tf")
+				return string(content)
+			}(),
+			Want: helper.Issues{
+				{
+					Rule:    NewLengthRule(),
+					Message: makeShortMessage("a"),
+					Range: hcl.Range{
+						Filename: "length_test.tf",
+						Start:    hcl.Pos{Line: 4, Column: 1},
+						End:      hcl.Pos{Line: 4, Column: 13},
+					},
+				},
+				{
+					Rule:    NewLengthRule(),
+					Message: makeMinLengthMessage("ab", 3),
+					Range: hcl.Range{
+						Filename: "length_test.tf",
+						Start:    hcl.Pos{Line: 6, Column: 1},
+						End:      hcl.Pos{Line: 6, Column: 14},
+					},
+				},
+				{
+					Rule:    NewLengthRule(),
+					Message: makeShortMessage("r1"),
+					Range: hcl.Range{
+						Filename: "length_test.tf",
+						Start:    hcl.Pos{Line: 9, Column: 3},
+						End:      hcl.Pos{Line: 9, Column: 12},
+					},
+				},
+				{
+					Rule:    NewLengthRule(),
+					Message: makeShortMessage("x"),
+					Range: hcl.Range{
+						Filename: "length_test.tf",
+						Start:    hcl.Pos{Line: 10, Column: 16},
+						End:      hcl.Pos{Line: 10, Column: 17},
+					},
+				},
+				{
+					Rule:    NewLengthRule(),
+					Message: makeShortMessage("d"),
+					Range: hcl.Range{
+						Filename: "length_test.tf",
+						Start:    hcl.Pos{Line: 16, Column: 16},
+						End:      hcl.Pos{Line: 16, Column: 17},
+					},
+				},
+			},
+		},
 	}
 
 	for _, tc := range cases {
 
 		// Run the tests and make sure the basic results are found...
-		runner := helper.TestRunner(t, map[string]string{"length_test.tf": tc.Content})
+		files := map[string]string{"length_test.tf": tc.Content}
+		if tc.Config != "" {
+			files[".tflint.hcl"] = tc.Config
+		}
+		runner := helper.TestRunner(t, files)
 		rule := NewLengthRule()
 
 		// ... no errors.
@@ -137,3 +201,11 @@ func TestLengthRule(t *testing.T) {
 func makeLengthMessage(name string) string {
 	return fmt.Sprintf("'%s' is %d characters and should not be longer than %d.", name, len(name), 16)
 }
+
+func makeMinLengthMessage(name string, limit int) string {
+	return fmt.Sprintf("'%s' is %d characters and should not be shorter than %d.", name, len(name), limit)
+}
+
+func makeShortMessage(name string) string {
+	return fmt.Sprintf("'%s' is too short to be meaningful.", name)
+}
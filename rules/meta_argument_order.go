@@ -0,0 +1,177 @@
+// Copyright (c) 2025 Steve Taranto <staranto@gmail.com>.
+// SPDX-License-Identifier: Apache-2.0
+
+package rules
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/logger"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// metaArgumentBlocks are the blocks that take meta-arguments.
+var metaArgumentBlocks = []BlockDef{
+	{Typ: "data", Labels: []string{"type", "name"}},
+	{Typ: "module", Labels: []string{"name"}},
+	{Typ: "resource", Labels: []string{"type", "name"}},
+}
+
+// moduleSourceArguments may lead a module block along with the first
+// meta-arguments.
+var moduleSourceArguments = []string{"source", "version"}
+
+var defaultMetaArgumentOrderConfig = metaArgumentOrderRuleConfig{
+	First: []string{"count", "for_each", "provider"},
+	Last:  []string{"lifecycle", "depends_on"},
+	Level: "warning",
+}
+
+// metaArgumentOrderRuleConfig represents the configuration for the
+// MetaArgumentOrderRule.
+type metaArgumentOrderRuleConfig struct {
+	First []string `hclext:"first,optional"`
+	Last  []string `hclext:"last,optional"`
+	Level string   `hclext:"level,optional"`
+}
+
+// MetaArgumentOrderRule checks whether meta-arguments come first and last in
+// their blocks.
+type MetaArgumentOrderRule struct {
+	tflint.DefaultRule
+	Config metaArgumentOrderRuleConfig
+}
+
+// Check checks whether the rule conditions are met.
+func (r *MetaArgumentOrderRule) Check(runner tflint.Runner) error {
+	if err := runner.DecodeRuleConfig(r.Name(), &r.Config); err != nil {
+		return err
+	}
+
+	content, err := runner.GetModuleContent(&hclext.BodySchema{
+		Blocks: buildBlockSchemas(metaArgumentBlocks),
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, block := range content.Blocks {
+		// JSON bodies have no order to check.
+		syntaxBlock := findSyntaxBlock(runner, block.DefRange)
+		if syntaxBlock == nil {
+			continue
+		}
+		file, err := runner.GetFile(block.DefRange.Filename)
+		if err != nil {
+			return err
+		}
+		r.checkBlock(runner, block, syntaxBlock, file.Bytes)
+	}
+
+	return nil
+}
+
+// checkBlock reports the meta-arguments that are out of place, or, when they
+// are in place, not set apart by a blank line.
+func (r *MetaArgumentOrderRule) checkBlock(runner tflint.Runner, block *hclext.Block, syntaxBlock *hclsyntax.Block, src []byte) {
+	first := r.Config.First
+	if block.Type == "module" && len(first) > 0 {
+		first = append(slices.Clone(first), moduleSourceArguments...)
+	}
+
+	var leading, middle, trailing []bodyItem
+	for _, item := range bodyItems(syntaxBlock.Body) {
+		switch {
+		case slices.Contains(first, item.name):
+			leading = append(leading, item)
+		case slices.Contains(r.Config.Last, item.name):
+			trailing = append(trailing, item)
+		default:
+			middle = append(middle, item)
+		}
+	}
+	fix := func(f tflint.Fixer) error {
+		return reorderBody(f, src, syntaxBlock, [][]bodyItem{leading, middle, trailing})
+	}
+
+	var messages []string
+	var items []bodyItem
+	for _, item := range leading {
+		if len(middle) > 0 && item.rng.Start.Byte > middle[0].rng.Start.Byte ||
+			len(trailing) > 0 && item.rng.Start.Byte > trailing[0].rng.Start.Byte {
+			messages = append(messages, fmt.Sprintf("'%s' should be at the top of %s.", item.name, describeBlock(block)))
+			items = append(items, item)
+		}
+	}
+	for _, item := range trailing {
+		if len(middle) > 0 && item.rng.Start.Byte < middle[len(middle)-1].rng.Start.Byte ||
+			len(leading) > 0 && item.rng.Start.Byte < leading[len(leading)-1].rng.Start.Byte {
+			messages = append(messages, fmt.Sprintf("'%s' should be at the bottom of %s.", item.name, describeBlock(block)))
+			items = append(items, item)
+		}
+	}
+
+	if len(items) == 0 {
+		if len(leading) > 0 && len(middle)+len(trailing) > 0 {
+			last, next := leading[len(leading)-1], bodyItem{}
+			if len(middle) > 0 {
+				next = middle[0]
+			} else {
+				next = trailing[0]
+			}
+			if !blankLineBetween(src, last, next) {
+				messages = append(messages, fmt.Sprintf("'%s' should be followed by a blank line.", last.name))
+				items = append(items, last)
+			}
+		}
+		if len(trailing) > 0 && len(leading)+len(middle) > 0 {
+			previous := bodyItem{}
+			if len(middle) > 0 {
+				previous = middle[len(middle)-1]
+			} else {
+				previous = leading[len(leading)-1]
+			}
+			if !blankLineBetween(src, previous, trailing[0]) {
+				messages = append(messages, fmt.Sprintf("'%s' should be preceded by a blank line.", trailing[0].name))
+				items = append(items, trailing[0])
+			}
+		}
+	}
+
+	for i, message := range messages {
+		if err := runner.EmitIssueWithFix(r, message, items[i].key, fix); err != nil {
+			logger.Error(err.Error())
+		}
+		logger.Debug(message)
+	}
+}
+
+// NewMetaArgumentOrderRule returns a new rule.
+func NewMetaArgumentOrderRule() *MetaArgumentOrderRule {
+	rule := &MetaArgumentOrderRule{}
+	rule.Config = defaultMetaArgumentOrderConfig
+	return rule
+}
+
+// Enabled returns whether the rule is enabled by default.
+func (r *MetaArgumentOrderRule) Enabled() bool {
+	return true
+}
+
+// Link returns the rule reference link.
+func (r *MetaArgumentOrderRule) Link() string {
+	return "https://github.com/staranto/tflint-ruleset-elements-of-style/blob/main/docs/rules/eos_meta_argument_order.md"
+}
+
+// Name returns the rule name.
+func (r *MetaArgumentOrderRule) Name() string {
+	return "eos_meta_argument_order"
+}
+
+// Severity returns the rule severity.
+func (r *MetaArgumentOrderRule) Severity() tflint.Severity {
+	return toSeverity(r.Config.Level)
+}
@@ -0,0 +1,169 @@
+// Copyright (c) 2025 Steve Taranto <staranto@gmail.com>.
+// SPDX-License-Identifier: Apache-2.0
+
+package rules
+
+import (
+	"flag"
+	"fmt"
+	"testing"
+
+	"os"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+var metaArgumentOrderDeep = flag.Bool("metaArgumentOrderDeep", false, "enable deep assert")
+
+func TestMetaArgumentOrderRule(t *testing.T) {
+	flag.Parse()
+
+	content, _ := os.ReadFile("testdata/meta_argument_order_test.tf")
+	fixed, _ := os.ReadFile("testdata/meta_argument_order_fixed_test.tf")
+
+	cases := []struct {
+		Name    string
+		Config  string
+		Content string
+		Want    helper.Issues
+		Fixed   string
+	}{
+		{
+			Name:    "meta_argument_order",
+			Content: string(content),
+			Want: helper.Issues{
+				{
+					Rule:    NewMetaArgumentOrderRule(),
+					Message: makeMetaArgumentTopMessage("count", `resource "aws_instance" "web"`),
+					Range: hcl.Range{
+						Filename: "meta_argument_order_test.tf",
+						Start:    hcl.Pos{Line: 8, Column: 3},
+						End:      hcl.Pos{Line: 8, Column: 8},
+					},
+				},
+				{
+					Rule:    NewMetaArgumentOrderRule(),
+					Message: makeMetaArgumentBottomMessage("depends_on", `resource "aws_instance" "web"`),
+					Range: hcl.Range{
+						Filename: "meta_argument_order_test.tf",
+						Start:    hcl.Pos{Line: 10, Column: 3},
+						End:      hcl.Pos{Line: 10, Column: 13},
+					},
+				},
+				{
+					Rule:    NewMetaArgumentOrderRule(),
+					Message: makeMetaArgumentFollowedMessage("for_each"),
+					Range: hcl.Range{
+						Filename: "meta_argument_order_test.tf",
+						Start:    hcl.Pos{Line: 18, Column: 3},
+						End:      hcl.Pos{Line: 18, Column: 11},
+					},
+				},
+				{
+					Rule:    NewMetaArgumentOrderRule(),
+					Message: makeMetaArgumentPrecededMessage("lifecycle"),
+					Range: hcl.Range{
+						Filename: "meta_argument_order_test.tf",
+						Start:    hcl.Pos{Line: 20, Column: 3},
+						End:      hcl.Pos{Line: 20, Column: 12},
+					},
+				},
+				{
+					Rule:    NewMetaArgumentOrderRule(),
+					Message: makeMetaArgumentPrecededMessage("depends_on"),
+					Range: hcl.Range{
+						Filename: "meta_argument_order_test.tf",
+						Start:    hcl.Pos{Line: 30, Column: 3},
+						End:      hcl.Pos{Line: 30, Column: 13},
+					},
+				},
+			},
+			Fixed: string(fixed),
+		},
+		{
+			Name: "last",
+			Config: `
+rule "eos_meta_argument_order" {
+  enabled = true
+  first   = []
+  last    = ["depends_on"]
+}`,
+			Content: string(content),
+			Want: helper.Issues{
+				{
+					Rule:    NewMetaArgumentOrderRule(),
+					Message: makeMetaArgumentBottomMessage("depends_on", `resource "aws_instance" "web"`),
+					Range: hcl.Range{
+						Filename: "meta_argument_order_test.tf",
+						Start:    hcl.Pos{Line: 10, Column: 3},
+						End:      hcl.Pos{Line: 10, Column: 13},
+					},
+				},
+				{
+					Rule:    NewMetaArgumentOrderRule(),
+					Message: makeMetaArgumentPrecededMessage("depends_on"),
+					Range: hcl.Range{
+						Filename: "meta_argument_order_test.tf",
+						Start:    hcl.Pos{Line: 30, Column: 3},
+						End:      hcl.Pos{Line: 30, Column: 13},
+					},
+				},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+
+		// Run the tests and make sure the basic results are found...
+		files := map[string]string{"meta_argument_order_test.tf": tc.Content}
+		if tc.Config != "" {
+			files[".tflint.hcl"] = tc.Config
+		}
+		runner := helper.TestRunner(t, files)
+		rule := NewMetaArgumentOrderRule()
+
+		// ... no errors.
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		// ... and the expected number of issues.
+		if len(runner.Issues) != len(tc.Want) {
+			t.Logf("Expected %d issues, got %d", len(tc.Want), len(runner.Issues))
+			for i, issue := range runner.Issues {
+				t.Logf("Issue %d: %s at %s", i, issue.Message, issue.Range)
+			}
+			t.Fatalf("Number of issues mismatch: got %d, want %d", len(runner.Issues), len(tc.Want))
+		}
+
+		t.Run(tc.Name, func(t *testing.T) {
+			if *metaArgumentOrderDeep {
+				helper.AssertIssues(t, tc.Want, runner.Issues)
+			} else {
+				helper.AssertIssuesWithoutRange(t, tc.Want, runner.Issues)
+			}
+
+			// ... and the expected fixes.
+			if tc.Fixed != "" {
+				helper.AssertChanges(t, map[string]string{"meta_argument_order_test.tf": tc.Fixed}, runner.Changes())
+			}
+		})
+	}
+}
+
+func makeMetaArgumentTopMessage(name string, block string) string {
+	return fmt.Sprintf("'%s' should be at the top of %s.", name, block)
+}
+
+func makeMetaArgumentBottomMessage(name string, block string) string {
+	return fmt.Sprintf("'%s' should be at the bottom of %s.", name, block)
+}
+
+func makeMetaArgumentFollowedMessage(name string) string {
+	return fmt.Sprintf("'%s' should be followed by a blank line.", name)
+}
+
+func makeMetaArgumentPrecededMessage(name string) string {
+	return fmt.Sprintf("'%s' should be preceded by a blank line.", name)
+}
@@ -0,0 +1,120 @@
+// Copyright (c) 2025 Steve Taranto <staranto@gmail.com>.
+// SPDX-License-Identifier: Apache-2.0
+
+package rules
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/logger"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+var defaultMixedSeparatorsConfig = mixedSeparatorsRuleConfig{
+	Level:  "warning",
+	Module: true,
+}
+
+// mixedSeparatorsRuleConfig represents the configuration for the
+// MixedSeparatorsRule.
+type mixedSeparatorsRuleConfig struct {
+	Level  string `hclext:"level,optional"`
+	Module bool   `hclext:"module,optional"`
+}
+
+// separatedName is a name using a single separator style.
+type separatedName struct {
+	block *hclext.Block
+	name  string
+}
+
+// MixedSeparatorsRule checks whether names mix '-' and '_' separators.
+type MixedSeparatorsRule struct {
+	tflint.DefaultRule
+	Config mixedSeparatorsRuleConfig
+
+	// dashed and underscored collect the names using only one separator.
+	dashed      []separatedName
+	underscored []separatedName
+}
+
+// Check checks whether the rule conditions are met.
+func (r *MixedSeparatorsRule) Check(runner tflint.Runner) error {
+	if err := runner.DecodeRuleConfig(r.Name(), &r.Config); err != nil {
+		return err
+	}
+
+	r.dashed, r.underscored = nil, nil
+	if err := CheckBlocksAndLocals(runner, allLintableBlocks, r, checkForMixedSeparators); err != nil {
+		return err
+	}
+
+	if !r.Config.Module || len(r.dashed) == 0 || len(r.underscored) == 0 {
+		return nil
+	}
+
+	// Ties go to '_', which is what Terraform itself uses.
+	majority, minority, names := "_", "-", r.dashed
+	if len(r.dashed) > len(r.underscored) {
+		majority, minority, names = "-", "_", r.underscored
+	}
+
+	for _, n := range names {
+		message := fmt.Sprintf("'%s' uses '%s' but most names in this module use '%s'.", n.name, minority, majority)
+		if err := runner.EmitIssue(r, message, n.block.DefRange); err != nil {
+			logger.Error(err.Error())
+		}
+		logger.Debug(message)
+	}
+
+	return nil
+}
+
+// checkForMixedSeparators checks if the name mixes separators, and collects
+// it for the module level check if it doesn't.
+func checkForMixedSeparators(runner tflint.Runner, r *MixedSeparatorsRule, block *hclext.Block, _ string, name string, _ string) {
+	dashed := strings.Contains(name, "-")
+	underscored := strings.Contains(name, "_")
+
+	switch {
+	case dashed && underscored:
+		message := fmt.Sprintf("'%s' mixes '-' and '_' separators.", name)
+		if err := runner.EmitIssue(r, message, block.DefRange); err != nil {
+			logger.Error(err.Error())
+		}
+		logger.Debug(message)
+	case dashed:
+		r.dashed = append(r.dashed, separatedName{block: block, name: name})
+	case underscored:
+		r.underscored = append(r.underscored, separatedName{block: block, name: name})
+	}
+}
+
+// NewMixedSeparatorsRule returns a new rule.
+func NewMixedSeparatorsRule() *MixedSeparatorsRule {
+	rule := &MixedSeparatorsRule{}
+	rule.Config = defaultMixedSeparatorsConfig
+	return rule
+}
+
+// Enabled returns whether the rule is enabled by default.
+func (r *MixedSeparatorsRule) Enabled() bool {
+	return true
+}
+
+// Link returns the rule reference link.
+func (r *MixedSeparatorsRule) Link() string {
+	return "https://github.com/staranto/tflint-ruleset-elements-of-style/blob/main/docs/rules/eos_mixed_separators.md"
+}
+
+// Name returns the rule name.
+func (r *MixedSeparatorsRule) Name() string {
+	return "eos_mixed_separators"
+}
+
+// Severity returns the rule severity.
+func (r *MixedSeparatorsRule) Severity() tflint.Severity {
+	return toSeverity(r.Config.Level)
+}
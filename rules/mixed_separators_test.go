@@ -0,0 +1,125 @@
+// Copyright (c) 2025 Steve Taranto <staranto@gmail.com>.
+// SPDX-License-Identifier: Apache-2.0
+
+package rules
+
+import (
+	"flag"
+	"fmt"
+	"testing"
+
+	"os"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+var mixedSeparatorsDeep = flag.Bool("mixedSeparatorsDeep", false, "enable deep assert")
+
+func TestMixedSeparatorsRule(t *testing.T) {
+	flag.Parse()
+
+	content, _ := os.ReadFile("testdata/mixed_separators_test.tf")
+
+	cases := []struct {
+		Name    string
+		Config  string
+		Content string
+		Want    helper.Issues
+	}{
+		{
+			Name:    "mixed_separators",
+			Content: string(content),
+			Want: helper.Issues{
+				{
+					Rule:    NewMixedSeparatorsRule(),
+					Message: makeMixedSeparatorsMessage("app-log_bucket"),
+					Range: hcl.Range{
+						Filename: "mixed_separators_test.tf",
+						Start:    hcl.Pos{Line: 4, Column: 1},
+						End:      hcl.Pos{Line: 4, Column: 26},
+					},
+				},
+				{
+					Rule:    NewMixedSeparatorsRule(),
+					Message: makeMinoritySeparatorMessage("log-prefix", "-", "_"),
+					Range: hcl.Range{
+						Filename: "mixed_separators_test.tf",
+						Start:    hcl.Pos{Line: 7, Column: 3},
+						End:      hcl.Pos{Line: 7, Column: 22},
+					},
+				},
+				{
+					Rule:    NewMixedSeparatorsRule(),
+					Message: makeMinoritySeparatorMessage("access-logs", "-", "_"),
+					Range: hcl.Range{
+						Filename: "mixed_separators_test.tf",
+						Start:    hcl.Pos{Line: 10, Column: 1},
+						End:      hcl.Pos{Line: 10, Column: 39},
+					},
+				},
+			},
+		},
+		{
+			Name: "module_disabled",
+			Config: `
+rule "eos_mixed_separators" {
+  enabled = true
+  module  = false
+}`,
+			Content: string(content),
+			Want: helper.Issues{
+				{
+					Rule:    NewMixedSeparatorsRule(),
+					Message: makeMixedSeparatorsMessage("app-log_bucket"),
+					Range: hcl.Range{
+						Filename: "mixed_separators_test.tf",
+						Start:    hcl.Pos{Line: 4, Column: 1},
+						End:      hcl.Pos{Line: 4, Column: 26},
+					},
+				},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+
+		// Run the tests and make sure the basic results are found...
+		files := map[string]string{"mixed_separators_test.tf": tc.Content}
+		if tc.Config != "" {
+			files[".tflint.hcl"] = tc.Config
+		}
+		runner := helper.TestRunner(t, files)
+		rule := NewMixedSeparatorsRule()
+
+		// ... no errors.
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		// ... and the expected number of issues.
+		if len(runner.Issues) != len(tc.Want) {
+			t.Logf("Expected %d issues, got %d", len(tc.Want), len(runner.Issues))
+			for i, issue := range runner.Issues {
+				t.Logf("Issue %d: %s at %s", i, issue.Message, issue.Range)
+			}
+			t.Fatalf("Number of issues mismatch: got %d, want %d", len(runner.Issues), len(tc.Want))
+		}
+
+		t.Run(tc.Name, func(t *testing.T) {
+			if *mixedSeparatorsDeep {
+				helper.AssertIssues(t, tc.Want, runner.Issues)
+			} else {
+				helper.AssertIssuesWithoutRange(t, tc.Want, runner.Issues)
+			}
+		})
+	}
+}
+
+func makeMixedSeparatorsMessage(name string) string {
+	return fmt.Sprintf("'%s' mixes '-' and '_' separators.", name)
+}
+
+func makeMinoritySeparatorMessage(name string, minority string, majority string) string {
+	return fmt.Sprintf("'%s' uses '%s' but most names in this module use '%s'.", name, minority, majority)
+}
@@ -0,0 +1,115 @@
+// Copyright (c) 2025 Steve Taranto <staranto@gmail.com>.
+// SPDX-License-Identifier: Apache-2.0
+
+package rules
+
+import (
+	"fmt"
+	"path"
+	"regexp"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/logger"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+var defaultNamingPatternConfig = namingPatternRuleConfig{
+	Level: "warning",
+}
+
+// namingPatternRuleConfig represents the configuration for the
+// NamingPatternRule.
+type namingPatternRuleConfig struct {
+	Patterns []namingPattern `hclext:"pattern,block"`
+	Level    string          `hclext:"level,optional"`
+}
+
+// namingPattern is a regex that names of the matching blocks must follow.
+// Match is a block type, such as "variable", or a glob over the name type,
+// such as "aws_iam_*".
+type namingPattern struct {
+	Match string `hclext:"match,label"`
+	Regex string `hclext:"regex"`
+	Hint  string `hclext:"hint,optional"`
+
+	regex *regexp.Regexp
+}
+
+// NamingPatternRule checks whether names follow the configured patterns.
+type NamingPatternRule struct {
+	tflint.DefaultRule
+	Config namingPatternRuleConfig
+}
+
+// Check checks whether the rule conditions are met.
+func (r *NamingPatternRule) Check(runner tflint.Runner) error {
+	if err := runner.DecodeRuleConfig(r.Name(), &r.Config); err != nil {
+		return err
+	}
+
+	for i, pattern := range r.Config.Patterns {
+		if _, err := path.Match(pattern.Match, ""); err != nil {
+			return fmt.Errorf("invalid match '%s': %w", pattern.Match, err)
+		}
+		regex, err := regexp.Compile(pattern.Regex)
+		if err != nil {
+			return fmt.Errorf("invalid regex for '%s': %w", pattern.Match, err)
+		}
+		r.Config.Patterns[i].regex = regex
+	}
+
+	return CheckBlocksAndLocals(runner, allLintableBlocks, r, checkForNamingPattern)
+}
+
+// checkForNamingPattern checks the name against every pattern matching its
+// type or block type.
+func checkForNamingPattern(runner tflint.Runner, r *NamingPatternRule, block *hclext.Block, typ string, name string, _ string) {
+	for _, pattern := range r.Config.Patterns {
+		typMatch, _ := path.Match(pattern.Match, typ)
+		blockMatch, _ := path.Match(pattern.Match, block.Type)
+		if !typMatch && !blockMatch {
+			continue
+		}
+		if pattern.regex.MatchString(name) {
+			continue
+		}
+
+		hint := pattern.Hint
+		if hint == "" {
+			hint = pattern.Regex
+		}
+
+		message := fmt.Sprintf("'%s' does not match the naming pattern for '%s' (%s).", name, pattern.Match, hint)
+		if err := runner.EmitIssue(r, message, block.DefRange); err != nil {
+			logger.Error(err.Error())
+		}
+		logger.Debug(message)
+	}
+}
+
+// NewNamingPatternRule returns a new rule.
+func NewNamingPatternRule() *NamingPatternRule {
+	rule := &NamingPatternRule{}
+	rule.Config = defaultNamingPatternConfig
+	return rule
+}
+
+// Enabled returns whether the rule is enabled by default.
+func (r *NamingPatternRule) Enabled() bool {
+	return true
+}
+
+// Link returns the rule reference link.
+func (r *NamingPatternRule) Link() string {
+	return "https://github.com/staranto/tflint-ruleset-elements-of-style/blob/main/docs/rules/eos_naming_pattern.md"
+}
+
+// Name returns the rule name.
+func (r *NamingPatternRule) Name() string {
+	return "eos_naming_pattern"
+}
+
+// Severity returns the rule severity.
+func (r *NamingPatternRule) Severity() tflint.Severity {
+	return toSeverity(r.Config.Level)
+}
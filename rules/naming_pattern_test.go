@@ -0,0 +1,124 @@
+// Copyright (c) 2025 Steve Taranto <staranto@gmail.com>.
+// SPDX-License-Identifier: Apache-2.0
+
+package rules
+
+import (
+	"flag"
+	"fmt"
+	"testing"
+
+	"os"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+var namingPatternDeep = flag.Bool("namingPatternDeep", false, "enable deep assert")
+
+func TestNamingPatternRule(t *testing.T) {
+	flag.Parse()
+
+	content, _ := os.ReadFile("testdata/naming_pattern_test.tf")
+
+	cases := []struct {
+		Name    string
+		Config  string
+		Content string
+		Want    helper.Issues
+	}{
+		{
+			Name:    "no_patterns",
+			Content: string(content),
+			Want:    helper.Issues{},
+		},
+		{
+			Name: "patterns",
+			Config: `
+rule "eos_naming_pattern" {
+  enabled = true
+
+  pattern "aws_iam_*" {
+    regex = "^[a-z]+(_[a-z]+)*$"
+    hint  = "lowercase words joined by '_'"
+  }
+
+  pattern "variable" {
+    regex = "^(in|out)_"
+    hint  = "prefixed with 'in_' or 'out_'"
+  }
+
+  pattern "local" {
+    regex = "^local_"
+  }
+}`,
+			Content: string(content),
+			Want: helper.Issues{
+				{
+					Rule:    NewNamingPatternRule(),
+					Message: makeNamingPatternMessage("Deployer", "aws_iam_*", "lowercase words joined by '_'"),
+					Range: hcl.Range{
+						Filename: "naming_pattern_test.tf",
+						Start:    hcl.Pos{Line: 4, Column: 1},
+						End:      hcl.Pos{Line: 4, Column: 35},
+					},
+				},
+				{
+					Rule:    NewNamingPatternRule(),
+					Message: makeNamingPatternMessage("region", "variable", "prefixed with 'in_' or 'out_'"),
+					Range: hcl.Range{
+						Filename: "naming_pattern_test.tf",
+						Start:    hcl.Pos{Line: 8, Column: 1},
+						End:      hcl.Pos{Line: 8, Column: 18},
+					},
+				},
+				{
+					Rule:    NewNamingPatternRule(),
+					Message: makeNamingPatternMessage("bucket", "local", "^local_"),
+					Range: hcl.Range{
+						Filename: "naming_pattern_test.tf",
+						Start:    hcl.Pos{Line: 11, Column: 3},
+						End:      hcl.Pos{Line: 11, Column: 18},
+					},
+				},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+
+		// Run the tests and make sure the basic results are found...
+		files := map[string]string{"naming_pattern_test.tf": tc.Content}
+		if tc.Config != "" {
+			files[".tflint.hcl"] = tc.Config
+		}
+		runner := helper.TestRunner(t, files)
+		rule := NewNamingPatternRule()
+
+		// ... no errors.
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		// ... and the expected number of issues.
+		if len(runner.Issues) != len(tc.Want) {
+			t.Logf("Expected %d issues, got %d", len(tc.Want), len(runner.Issues))
+			for i, issue := range runner.Issues {
+				t.Logf("Issue %d: %s at %s", i, issue.Message, issue.Range)
+			}
+			t.Fatalf("Number of issues mismatch: got %d, want %d", len(runner.Issues), len(tc.Want))
+		}
+
+		t.Run(tc.Name, func(t *testing.T) {
+			if *namingPatternDeep {
+				helper.AssertIssues(t, tc.Want, runner.Issues)
+			} else {
+				helper.AssertIssuesWithoutRange(t, tc.Want, runner.Issues)
+			}
+		})
+	}
+}
+
+func makeNamingPatternMessage(name string, match string, hint string) string {
+	return fmt.Sprintf("'%s' does not match the naming pattern for '%s' (%s).", name, match, hint)
+}
@@ -0,0 +1,138 @@
+// Copyright (c) 2025 Steve Taranto <staranto@gmail.com>.
+// SPDX-License-Identifier: Apache-2.0
+
+package rules
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/logger"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+var numberedNameParser = regexp.MustCompile(`^(.*?[^0-9_-])[_-]?([0-9]+)$`)
+
+var defaultNumberedNamesConfig = numberedNamesRuleConfig{
+	Level:     "warning",
+	Threshold: 2,
+}
+
+// numberedNamesRuleConfig represents the configuration for the
+// NumberedNamesRule.
+type numberedNamesRuleConfig struct {
+	Level     string `hclext:"level,optional"`
+	Threshold int    `hclext:"threshold,optional"`
+}
+
+// numberedName is a name ending with a numeric suffix.
+type numberedName struct {
+	block  *hclext.Block
+	name   string
+	number int
+}
+
+// NumberedNamesRule checks for families of names that differ only by a
+// numeric suffix.
+type NumberedNamesRule struct {
+	tflint.DefaultRule
+	Config numberedNamesRuleConfig
+
+	// families collects the numbered names by kind and stem, and keys records
+	// the order in which the families were found.
+	families map[string][]numberedName
+	keys     []string
+}
+
+// Check checks whether the rule conditions are met.
+func (r *NumberedNamesRule) Check(runner tflint.Runner) error {
+	if err := runner.DecodeRuleConfig(r.Name(), &r.Config); err != nil {
+		return err
+	}
+
+	r.families, r.keys = map[string][]numberedName{}, nil
+	if err := CheckBlocksAndLocals(runner, allLintableBlocks, r, collectNumberedName); err != nil {
+		return err
+	}
+
+	for _, key := range r.keys {
+		family := r.families[key]
+		if len(family) < max(r.Config.Threshold, 2) {
+			continue
+		}
+
+		slices.SortFunc(family, func(a, b numberedName) int {
+			if a.number != b.number {
+				return a.number - b.number
+			}
+			return strings.Compare(a.name, b.name)
+		})
+
+		names := make([]string, len(family))
+		for i, member := range family {
+			names[i] = fmt.Sprintf("'%s'", member.name)
+		}
+
+		for _, member := range family {
+			message := fmt.Sprintf("'%s' is one of %d numbered names (%s). Consider count or for_each.", member.name, len(family), strings.Join(names, ", "))
+			if err := runner.EmitIssue(r, message, member.block.DefRange); err != nil {
+				logger.Error(err.Error())
+			}
+			logger.Debug(message)
+		}
+	}
+
+	return nil
+}
+
+// collectNumberedName adds the name to its family if it has a numeric suffix.
+// Families are keyed by kind and by the lowercase stem, so "subnet1" and
+// "subnet_2" are siblings, but a data source and a resource are not.
+func collectNumberedName(_ tflint.Runner, r *NumberedNamesRule, block *hclext.Block, typ string, name string, _ string) {
+	matches := numberedNameParser.FindStringSubmatch(name)
+	if matches == nil {
+		return
+	}
+
+	number, err := strconv.Atoi(matches[2])
+	if err != nil {
+		return
+	}
+
+	key := nameKind(block, typ) + "." + strings.ToLower(matches[1])
+	if _, ok := r.families[key]; !ok {
+		r.keys = append(r.keys, key)
+	}
+	r.families[key] = append(r.families[key], numberedName{block: block, name: name, number: number})
+}
+
+// NewNumberedNamesRule returns a new rule.
+func NewNumberedNamesRule() *NumberedNamesRule {
+	rule := &NumberedNamesRule{}
+	rule.Config = defaultNumberedNamesConfig
+	return rule
+}
+
+// Enabled returns whether the rule is enabled by default.
+func (r *NumberedNamesRule) Enabled() bool {
+	return true
+}
+
+// Link returns the rule reference link.
+func (r *NumberedNamesRule) Link() string {
+	return "https://github.com/staranto/tflint-ruleset-elements-of-style/blob/main/docs/rules/eos_numbered_names.md"
+}
+
+// Name returns the rule name.
+func (r *NumberedNamesRule) Name() string {
+	return "eos_numbered_names"
+}
+
+// Severity returns the rule severity.
+func (r *NumberedNamesRule) Severity() tflint.Severity {
+	return toSeverity(r.Config.Level)
+}
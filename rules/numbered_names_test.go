@@ -0,0 +1,164 @@
+// Copyright (c) 2025 Steve Taranto <staranto@gmail.com>.
+// SPDX-License-Identifier: Apache-2.0
+
+package rules
+
+import (
+	"flag"
+	"fmt"
+	"strings"
+	"testing"
+
+	"os"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+var numberedNamesDeep = flag.Bool("numberedNamesDeep", false, "enable deep assert")
+
+func TestNumberedNamesRule(t *testing.T) {
+	flag.Parse()
+
+	content, _ := os.ReadFile("testdata/numbered_names_test.tf")
+	subnets := []string{"subnet1", "subnet2", "subnet_3"}
+	zones := []string{"zone-1", "zone-2"}
+
+	cases := []struct {
+		Name    string
+		Config  string
+		Content string
+		Want    helper.Issues
+	}{
+		{
+			Name:    "numbered_names",
+			Content: string(content),
+			Want: helper.Issues{
+				{
+					Rule:    NewNumberedNamesRule(),
+					Message: makeNumberedNamesMessage("subnet1", subnets),
+					Range: hcl.Range{
+						Filename: "numbered_names_test.tf",
+						Start:    hcl.Pos{Line: 4, Column: 1},
+						End:      hcl.Pos{Line: 4, Column: 32},
+					},
+				},
+				{
+					Rule:    NewNumberedNamesRule(),
+					Message: makeNumberedNamesMessage("subnet2", subnets),
+					Range: hcl.Range{
+						Filename: "numbered_names_test.tf",
+						Start:    hcl.Pos{Line: 8, Column: 1},
+						End:      hcl.Pos{Line: 8, Column: 32},
+					},
+				},
+				{
+					Rule:    NewNumberedNamesRule(),
+					Message: makeNumberedNamesMessage("subnet_3", subnets),
+					Range: hcl.Range{
+						Filename: "numbered_names_test.tf",
+						Start:    hcl.Pos{Line: 12, Column: 1},
+						End:      hcl.Pos{Line: 12, Column: 33},
+					},
+				},
+				{
+					Rule:    NewNumberedNamesRule(),
+					Message: makeNumberedNamesMessage("zone-1", zones),
+					Range: hcl.Range{
+						Filename: "numbered_names_test.tf",
+						Start:    hcl.Pos{Line: 17, Column: 3},
+						End:      hcl.Pos{Line: 17, Column: 24},
+					},
+				},
+				{
+					Rule:    NewNumberedNamesRule(),
+					Message: makeNumberedNamesMessage("zone-2", zones),
+					Range: hcl.Range{
+						Filename: "numbered_names_test.tf",
+						Start:    hcl.Pos{Line: 18, Column: 3},
+						End:      hcl.Pos{Line: 18, Column: 24},
+					},
+				},
+			},
+		},
+		{
+			Name: "threshold",
+			Config: `
+rule "eos_numbered_names" {
+  enabled   = true
+  threshold = 3
+}`,
+			Content: string(content),
+			Want: helper.Issues{
+				{
+					Rule:    NewNumberedNamesRule(),
+					Message: makeNumberedNamesMessage("subnet1", subnets),
+					Range: hcl.Range{
+						Filename: "numbered_names_test.tf",
+						Start:    hcl.Pos{Line: 4, Column: 1},
+						End:      hcl.Pos{Line: 4, Column: 32},
+					},
+				},
+				{
+					Rule:    NewNumberedNamesRule(),
+					Message: makeNumberedNamesMessage("subnet2", subnets),
+					Range: hcl.Range{
+						Filename: "numbered_names_test.tf",
+						Start:    hcl.Pos{Line: 8, Column: 1},
+						End:      hcl.Pos{Line: 8, Column: 32},
+					},
+				},
+				{
+					Rule:    NewNumberedNamesRule(),
+					Message: makeNumberedNamesMessage("subnet_3", subnets),
+					Range: hcl.Range{
+						Filename: "numbered_names_test.tf",
+						Start:    hcl.Pos{Line: 12, Column: 1},
+						End:      hcl.Pos{Line: 12, Column: 33},
+					},
+				},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+
+		// Run the tests and make sure the basic results are found...
+		files := map[string]string{"numbered_names_test.tf": tc.Content}
+		if tc.Config != "" {
+			files[".tflint.hcl"] = tc.Config
+		}
+		runner := helper.TestRunner(t, files)
+		rule := NewNumberedNamesRule()
+
+		// ... no errors.
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		// ... and the expected number of issues.
+		if len(runner.Issues) != len(tc.Want) {
+			t.Logf("Expected %d issues, got %d", len(tc.Want), len(runner.Issues))
+			for i, issue := range runner.Issues {
+				t.Logf("Issue %d: %s at %s", i, issue.Message, issue.Range)
+			}
+			t.Fatalf("Number of issues mismatch: got %d, want %d", len(runner.Issues), len(tc.Want))
+		}
+
+		t.Run(tc.Name, func(t *testing.T) {
+			if *numberedNamesDeep {
+				helper.AssertIssues(t, tc.Want, runner.Issues)
+			} else {
+				helper.AssertIssuesWithoutRange(t, tc.Want, runner.Issues)
+			}
+		})
+	}
+}
+
+func makeNumberedNamesMessage(name string, family []string) string {
+	quoted := make([]string, len(family))
+	for i, member := range family {
+		quoted[i] = fmt.Sprintf("'%s'", member)
+	}
+	return fmt.Sprintf("'%s' is one of %d numbered names (%s). Consider count or for_each.", name, len(family), strings.Join(quoted, ", "))
+}
@@ -0,0 +1,104 @@
+// Copyright (c) 2025 Steve Taranto <staranto@gmail.com>.
+// SPDX-License-Identifier: Apache-2.0
+
+package rules
+
+import (
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// blankLineParser matches a blank line between two items.
+var blankLineParser = regexp.MustCompile(`\n[ \t]*\r?\n`)
+
+// bodyItem is an attribute or nested block of a native syntax body.
+type bodyItem struct {
+	// name is the attribute name or the block type.
+	name string
+	// rng covers the whole item, and key covers its name or type.
+	rng hcl.Range
+	key hcl.Range
+}
+
+// bodyItems returns the attributes and nested blocks of a body in source
+// order.
+func bodyItems(body *hclsyntax.Body) []bodyItem {
+	var items []bodyItem
+	for _, attr := range body.Attributes {
+		items = append(items, bodyItem{name: attr.Name, rng: attr.SrcRange, key: attr.NameRange})
+	}
+	for _, block := range body.Blocks {
+		items = append(items, bodyItem{name: block.Type, rng: block.Range(), key: block.TypeRange})
+	}
+	slices.SortFunc(items, func(a, b bodyItem) int {
+		return a.rng.Start.Byte - b.rng.Start.Byte
+	})
+	return items
+}
+
+// blankLineBetween reports whether a blank line separates two items.
+func blankLineBetween(src []byte, a bodyItem, b bodyItem) bool {
+	return blankLineParser.Match(src[a.rng.End.Byte:b.rng.Start.Byte])
+}
+
+// reorderBody rewrites the items of a block's body as the given groups, which
+// must hold every item. Each item moves with its own lines, which takes along
+// the comments above it and at the end of its line. The items in a group keep
+// their blank-line separation, and the groups are separated by a blank line.
+// Bodies with items that share a line with the braces or with each other are
+// not supported.
+func reorderBody(f tflint.Fixer, src []byte, block *hclsyntax.Block, groups [][]bodyItem) error {
+	items := bodyItems(block.Body)
+	if len(items) == 0 {
+		return nil
+	}
+
+	lineStarts := []int{0, 0}
+	for i, ch := range src {
+		if ch == '\n' {
+			lineStarts = append(lineStarts, i+1)
+		}
+	}
+	lineStarts = append(lineStarts, len(src))
+
+	// chunks holds the lines of each item, less any leading blank lines, and
+	// blanks records which items had them.
+	chunks := map[int]string{}
+	blanks := map[int]bool{}
+	previousLine := block.OpenBraceRange.Start.Line
+	for _, item := range items {
+		if item.rng.Start.Line == previousLine || item.rng.End.Line == block.CloseBraceRange.Start.Line {
+			return tflint.ErrFixNotSupported
+		}
+		chunk := string(src[lineStarts[previousLine+1]:lineStarts[item.rng.End.Line+1]])
+		trimmed := strings.TrimLeft(chunk, " \t\r\n")
+		trimmed = chunk[strings.LastIndex(chunk[:len(chunk)-len(trimmed)], "\n")+1:]
+		chunks[item.rng.Start.Byte] = trimmed
+		blanks[item.rng.Start.Byte] = trimmed != chunk && previousLine != block.OpenBraceRange.Start.Line
+		previousLine = item.rng.End.Line
+	}
+
+	var sb strings.Builder
+	for _, group := range groups {
+		for i, item := range group {
+			if (i == 0 && sb.Len() > 0) || (i > 0 && blanks[item.rng.Start.Byte]) {
+				sb.WriteString("\n")
+			}
+			sb.WriteString(chunks[item.rng.Start.Byte])
+		}
+	}
+
+	start := lineStarts[block.OpenBraceRange.Start.Line+1]
+	end := lineStarts[items[len(items)-1].rng.End.Line+1]
+	rng := hcl.Range{
+		Filename: block.OpenBraceRange.Filename,
+		Start:    hcl.Pos{Line: block.OpenBraceRange.Start.Line + 1, Column: 1, Byte: start},
+		End:      hcl.Pos{Line: items[len(items)-1].rng.End.Line + 1, Column: 1, Byte: end},
+	}
+	return f.ReplaceText(rng, sb.String())
+}
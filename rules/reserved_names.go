@@ -0,0 +1,243 @@
+// Copyright (c) 2025 Steve Taranto <staranto@gmail.com>.
+// SPDX-License-Identifier: Apache-2.0
+
+package rules
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/logger"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// reservedNames maps each reserved name to what it is reserved as.
+var reservedNames = map[string]string{
+	// Meta-arguments.
+	"connection":  "meta-argument",
+	"count":       "meta-argument",
+	"depends_on":  "meta-argument",
+	"for_each":    "meta-argument",
+	"lifecycle":   "meta-argument",
+	"provider":    "meta-argument",
+	"providers":   "meta-argument",
+	"provisioner": "meta-argument",
+	"source":      "meta-argument",
+	"version":     "meta-argument",
+
+	// Reserved words.
+	"check":     "reserved word",
+	"data":      "reserved word",
+	"each":      "reserved word",
+	"ephemeral": "reserved word",
+	"false":     "reserved word",
+	"for":       "reserved word",
+	"if":        "reserved word",
+	"import":    "reserved word",
+	"in":        "reserved word",
+	"local":     "reserved word",
+	"locals":    "reserved word",
+	"module":    "reserved word",
+	"moved":     "reserved word",
+	"null":      "reserved word",
+	"output":    "reserved word",
+	"path":      "reserved word",
+	"removed":   "reserved word",
+	"resource":  "reserved word",
+	"self":      "reserved word",
+	"terraform": "reserved word",
+	"true":      "reserved word",
+	"var":       "reserved word",
+	"variable":  "reserved word",
+
+	// Built-in functions.
+	"abs":              "built-in function",
+	"abspath":          "built-in function",
+	"alltrue":          "built-in function",
+	"anytrue":          "built-in function",
+	"base64decode":     "built-in function",
+	"base64encode":     "built-in function",
+	"base64gunzip":     "built-in function",
+	"base64gzip":       "built-in function",
+	"base64sha256":     "built-in function",
+	"base64sha512":     "built-in function",
+	"basename":         "built-in function",
+	"bcrypt":           "built-in function",
+	"can":              "built-in function",
+	"ceil":             "built-in function",
+	"chomp":            "built-in function",
+	"chunklist":        "built-in function",
+	"cidrhost":         "built-in function",
+	"cidrnetmask":      "built-in function",
+	"cidrsubnet":       "built-in function",
+	"cidrsubnets":      "built-in function",
+	"coalesce":         "built-in function",
+	"coalescelist":     "built-in function",
+	"compact":          "built-in function",
+	"concat":           "built-in function",
+	"contains":         "built-in function",
+	"csvdecode":        "built-in function",
+	"dirname":          "built-in function",
+	"distinct":         "built-in function",
+	"element":          "built-in function",
+	"endswith":         "built-in function",
+	"ephemeralasnull":  "built-in function",
+	"file":             "built-in function",
+	"filebase64":       "built-in function",
+	"filebase64sha256": "built-in function",
+	"filebase64sha512": "built-in function",
+	"fileexists":       "built-in function",
+	"filemd5":          "built-in function",
+	"fileset":          "built-in function",
+	"filesha1":         "built-in function",
+	"filesha256":       "built-in function",
+	"filesha512":       "built-in function",
+	"flatten":          "built-in function",
+	"floor":            "built-in function",
+	"format":           "built-in function",
+	"formatdate":       "built-in function",
+	"formatlist":       "built-in function",
+	"indent":           "built-in function",
+	"index":            "built-in function",
+	"issensitive":      "built-in function",
+	"join":             "built-in function",
+	"jsondecode":       "built-in function",
+	"jsonencode":       "built-in function",
+	"keys":             "built-in function",
+	"length":           "built-in function",
+	"log":              "built-in function",
+	"lookup":           "built-in function",
+	"lower":            "built-in function",
+	"matchkeys":        "built-in function",
+	"max":              "built-in function",
+	"md5":              "built-in function",
+	"merge":            "built-in function",
+	"min":              "built-in function",
+	"nonsensitive":     "built-in function",
+	"one":              "built-in function",
+	"parseint":         "built-in function",
+	"pathexpand":       "built-in function",
+	"plantimestamp":    "built-in function",
+	"pow":              "built-in function",
+	"range":            "built-in function",
+	"regex":            "built-in function",
+	"regexall":         "built-in function",
+	"replace":          "built-in function",
+	"reverse":          "built-in function",
+	"rsadecrypt":       "built-in function",
+	"sensitive":        "built-in function",
+	"setintersection":  "built-in function",
+	"setproduct":       "built-in function",
+	"setsubtract":      "built-in function",
+	"setunion":         "built-in function",
+	"sha1":             "built-in function",
+	"sha256":           "built-in function",
+	"sha512":           "built-in function",
+	"signum":           "built-in function",
+	"slice":            "built-in function",
+	"sort":             "built-in function",
+	"split":            "built-in function",
+	"startswith":       "built-in function",
+	"strcontains":      "built-in function",
+	"strrev":           "built-in function",
+	"substr":           "built-in function",
+	"sum":              "built-in function",
+	"templatefile":     "built-in function",
+	"templatestring":   "built-in function",
+	"textdecodebase64": "built-in function",
+	"textencodebase64": "built-in function",
+	"timeadd":          "built-in function",
+	"timecmp":          "built-in function",
+	"timestamp":        "built-in function",
+	"title":            "built-in function",
+	"tobool":           "built-in function",
+	"tolist":           "built-in function",
+	"tomap":            "built-in function",
+	"tonumber":         "built-in function",
+	"toset":            "built-in function",
+	"tostring":         "built-in function",
+	"transpose":        "built-in function",
+	"trim":             "built-in function",
+	"trimprefix":       "built-in function",
+	"trimspace":        "built-in function",
+	"trimsuffix":       "built-in function",
+	"try":              "built-in function",
+	"type":             "built-in function",
+	"upper":            "built-in function",
+	"urlencode":        "built-in function",
+	"uuid":             "built-in function",
+	"uuidv5":           "built-in function",
+	"values":           "built-in function",
+	"yamldecode":       "built-in function",
+	"yamlencode":       "built-in function",
+	"zipmap":           "built-in function",
+}
+
+var defaultReservedNamesConfig = reservedNamesRuleConfig{
+	Level: "warning",
+}
+
+// reservedNamesRuleConfig represents the configuration for the
+// ReservedNamesRule.
+type reservedNamesRuleConfig struct {
+	Allow []string `hclext:"allow,optional"`
+	Level string   `hclext:"level,optional"`
+}
+
+// ReservedNamesRule checks whether a name collides with a meta-argument,
+// reserved word or built-in function.
+type ReservedNamesRule struct {
+	tflint.DefaultRule
+	Config reservedNamesRuleConfig
+}
+
+// Check checks whether the rule conditions are met.
+func (r *ReservedNamesRule) Check(runner tflint.Runner) error {
+	if err := runner.DecodeRuleConfig(r.Name(), &r.Config); err != nil {
+		return err
+	}
+
+	return CheckBlocksAndLocals(runner, allLintableBlocks, r, checkForReserved)
+}
+
+// checkForReserved checks if the name is reserved.
+func checkForReserved(runner tflint.Runner, r *ReservedNamesRule, block *hclext.Block, _ string, name string, _ string) {
+	kind, ok := reservedNames[name]
+	if !ok || slices.Contains(r.Config.Allow, name) {
+		return
+	}
+
+	message := fmt.Sprintf("'%s' is the name of a %s.", name, kind)
+	if err := runner.EmitIssue(r, message, block.DefRange); err != nil {
+		logger.Error(err.Error())
+	}
+	logger.Debug(message)
+}
+
+// NewReservedNamesRule returns a new rule.
+func NewReservedNamesRule() *ReservedNamesRule {
+	rule := &ReservedNamesRule{}
+	rule.Config = defaultReservedNamesConfig
+	return rule
+}
+
+// Enabled returns whether the rule is enabled by default.
+func (r *ReservedNamesRule) Enabled() bool {
+	return true
+}
+
+// Link returns the rule reference link.
+func (r *ReservedNamesRule) Link() string {
+	return "https://github.com/staranto/tflint-ruleset-elements-of-style/blob/main/docs/rules/eos_reserved_names.md"
+}
+
+// Name returns the rule name.
+func (r *ReservedNamesRule) Name() string {
+	return "eos_reserved_names"
+}
+
+// Severity returns the rule severity.
+func (r *ReservedNamesRule) Severity() tflint.Severity {
+	return toSeverity(r.Config.Level)
+}
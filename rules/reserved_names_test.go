@@ -0,0 +1,139 @@
+// Copyright (c) 2025 Steve Taranto <staranto@gmail.com>.
+// SPDX-License-Identifier: Apache-2.0
+
+package rules
+
+import (
+	"flag"
+	"fmt"
+	"testing"
+
+	"os"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+var reservedNamesDeep = flag.Bool("reservedNamesDeep", false, "enable deep assert")
+
+func TestReservedNamesRule(t *testing.T) {
+	flag.Parse()
+
+	content, _ := os.ReadFile("testdata/reserved_names_test.tf")
+
+	cases := []struct {
+		Name    string
+		Config  string
+		Content string
+		Want    helper.Issues
+	}{
+		{
+			Name:    "reserved_names",
+			Content: string(content),
+			Want: helper.Issues{
+				{
+					Rule:    NewReservedNamesRule(),
+					Message: makeReservedMessage("count", "meta-argument"),
+					Range: hcl.Range{
+						Filename: "reserved_names_test.tf",
+						Start:    hcl.Pos{Line: 4, Column: 1},
+						End:      hcl.Pos{Line: 4, Column: 17},
+					},
+				},
+				{
+					Rule:    NewReservedNamesRule(),
+					Message: makeReservedMessage("each", "reserved word"),
+					Range: hcl.Range{
+						Filename: "reserved_names_test.tf",
+						Start:    hcl.Pos{Line: 7, Column: 3},
+						End:      hcl.Pos{Line: 7, Column: 17},
+					},
+				},
+				{
+					Rule:    NewReservedNamesRule(),
+					Message: makeReservedMessage("length", "built-in function"),
+					Range: hcl.Range{
+						Filename: "reserved_names_test.tf",
+						Start:    hcl.Pos{Line: 10, Column: 1},
+						End:      hcl.Pos{Line: 10, Column: 16},
+					},
+				},
+				{
+					Rule:    NewReservedNamesRule(),
+					Message: makeReservedMessage("source", "meta-argument"),
+					Range: hcl.Range{
+						Filename: "reserved_names_test.tf",
+						Start:    hcl.Pos{Line: 14, Column: 1},
+						End:      hcl.Pos{Line: 14, Column: 16},
+					},
+				},
+			},
+		},
+		{
+			Name: "allow",
+			Config: `
+rule "eos_reserved_names" {
+  enabled = true
+  allow   = ["length", "source"]
+}`,
+			Content: string(content),
+			Want: helper.Issues{
+				{
+					Rule:    NewReservedNamesRule(),
+					Message: makeReservedMessage("count", "meta-argument"),
+					Range: hcl.Range{
+						Filename: "reserved_names_test.tf",
+						Start:    hcl.Pos{Line: 4, Column: 1},
+						End:      hcl.Pos{Line: 4, Column: 17},
+					},
+				},
+				{
+					Rule:    NewReservedNamesRule(),
+					Message: makeReservedMessage("each", "reserved word"),
+					Range: hcl.Range{
+						Filename: "reserved_names_test.tf",
+						Start:    hcl.Pos{Line: 7, Column: 3},
+						End:      hcl.Pos{Line: 7, Column: 17},
+					},
+				},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+
+		// Run the tests and make sure the basic results are found...
+		files := map[string]string{"reserved_names_test.tf": tc.Content}
+		if tc.Config != "" {
+			files[".tflint.hcl"] = tc.Config
+		}
+		runner := helper.TestRunner(t, files)
+		rule := NewReservedNamesRule()
+
+		// ... no errors.
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		// ... and the expected number of issues.
+		if len(runner.Issues) != len(tc.Want) {
+			t.Logf("Expected %d issues, got %d", len(tc.Want), len(runner.Issues))
+			for i, issue := range runner.Issues {
+				t.Logf("Issue %d: %s at %s", i, issue.Message, issue.Range)
+			}
+			t.Fatalf("Number of issues mismatch: got %d, want %d", len(runner.Issues), len(tc.Want))
+		}
+
+		t.Run(tc.Name, func(t *testing.T) {
+			if *reservedNamesDeep {
+				helper.AssertIssues(t, tc.Want, runner.Issues)
+			} else {
+				helper.AssertIssuesWithoutRange(t, tc.Want, runner.Issues)
+			}
+		})
+	}
+}
+
+func makeReservedMessage(name string, kind string) string {
+	return fmt.Sprintf("'%s' is the name of a %s.", name, kind)
+}
@@ -0,0 +1,168 @@
+// Copyright (c) 2025 Steve Taranto <staranto@gmail.com>.
+// SPDX-License-Identifier: Apache-2.0
+
+package rules
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/logger"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// sensitiveBlocks are the blocks that can be marked sensitive.
+var sensitiveBlocks = []BlockDef{
+	{Typ: "output", Labels: []string{"name"}, Attributes: []string{"sensitive", "value"}},
+	{Typ: "variable", Labels: []string{"name"}, Attributes: []string{"sensitive"}},
+}
+
+var defaultSensitiveNamesConfig = sensitiveNamesRuleConfig{
+	Level: "warning",
+	Tokens: []string{
+		"access_key", "api_key", "credential", "credentials", "passphrase",
+		"passwd", "password", "private_key", "secret", "token",
+	},
+}
+
+// sensitiveNamesRuleConfig represents the configuration for the
+// SensitiveNamesRule.
+type sensitiveNamesRuleConfig struct {
+	Level  string   `hclext:"level,optional"`
+	Tokens []string `hclext:"tokens,optional"`
+}
+
+// SensitiveNamesRule checks whether variables and outputs that look like
+// secrets are marked sensitive.
+type SensitiveNamesRule struct {
+	tflint.DefaultRule
+	Config sensitiveNamesRuleConfig
+}
+
+// Check checks whether the rule conditions are met.
+func (r *SensitiveNamesRule) Check(runner tflint.Runner) error {
+	if err := runner.DecodeRuleConfig(r.Name(), &r.Config); err != nil {
+		return err
+	}
+
+	content, err := runner.GetModuleContent(&hclext.BodySchema{
+		Blocks: buildBlockSchemas(sensitiveBlocks),
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	sensitiveVariables := map[string]bool{}
+	for _, block := range content.Blocks {
+		if block.Type == "variable" && isSensitive(block) {
+			sensitiveVariables[block.Labels[0]] = true
+		}
+	}
+
+	for _, block := range content.Blocks {
+		if isSensitive(block) {
+			continue
+		}
+
+		// Point at sensitive = false, if that is what there is.
+		rng := block.DefRange
+		if attr, ok := block.Body.Attributes["sensitive"]; ok {
+			rng = attr.Expr.Range()
+		}
+
+		name := block.Labels[0]
+		if token := r.secretToken(name); token != "" {
+			r.emit(runner, fmt.Sprintf("'%s' contains '%s' but is not marked sensitive.", name, token), rng)
+			continue
+		}
+
+		value, ok := block.Body.Attributes["value"]
+		if !ok {
+			continue
+		}
+		for _, traversal := range value.Expr.Variables() {
+			if variable := referencedVariable(traversal); sensitiveVariables[variable] {
+				r.emit(runner, fmt.Sprintf("'%s' exposes the sensitive variable '%s' but is not marked sensitive.", name, variable), rng)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// secretToken returns the first token that the words of the name contain, or
+// "" if there is none. A token of several words, like "api_key", matches the
+// same words in a row.
+func (r *SensitiveNamesRule) secretToken(name string) string {
+	words := tokenizeName(name)
+	for _, token := range r.Config.Tokens {
+		tokenWords := tokenizeName(token)
+		for i := 0; i+len(tokenWords) <= len(words); i++ {
+			if slices.Equal(words[i:i+len(tokenWords)], tokenWords) {
+				return token
+			}
+		}
+	}
+	return ""
+}
+
+// emit reports a block that should be marked sensitive.
+func (r *SensitiveNamesRule) emit(runner tflint.Runner, message string, rng hcl.Range) {
+	if err := runner.EmitIssue(r, message, rng); err != nil {
+		logger.Error(err.Error())
+	}
+	logger.Debug(message)
+}
+
+// isSensitive reports whether a block is marked sensitive = true.
+func isSensitive(block *hclext.Block) bool {
+	attr, ok := block.Body.Attributes["sensitive"]
+	if !ok {
+		return false
+	}
+	value, diags := attr.Expr.Value(nil)
+	return !diags.HasErrors() && value.Type() == cty.Bool && value.IsKnown() && value.True()
+}
+
+// referencedVariable returns the name of the variable a traversal refers to,
+// as "region" for var.region, or "" if it refers to something else.
+func referencedVariable(traversal hcl.Traversal) string {
+	if traversal.RootName() != "var" || len(traversal) < 2 {
+		return ""
+	}
+	if attr, ok := traversal[1].(hcl.TraverseAttr); ok {
+		return attr.Name
+	}
+	return ""
+}
+
+// NewSensitiveNamesRule returns a new rule.
+func NewSensitiveNamesRule() *SensitiveNamesRule {
+	rule := &SensitiveNamesRule{}
+	rule.Config = defaultSensitiveNamesConfig
+	return rule
+}
+
+// Enabled returns whether the rule is enabled by default.
+func (r *SensitiveNamesRule) Enabled() bool {
+	return true
+}
+
+// Link returns the rule reference link.
+func (r *SensitiveNamesRule) Link() string {
+	return "https://github.com/staranto/tflint-ruleset-elements-of-style/blob/main/docs/rules/eos_sensitive_names.md"
+}
+
+// Name returns the rule name.
+func (r *SensitiveNamesRule) Name() string {
+	return "eos_sensitive_names"
+}
+
+// Severity returns the rule severity.
+func (r *SensitiveNamesRule) Severity() tflint.Severity {
+	return toSeverity(r.Config.Level)
+}
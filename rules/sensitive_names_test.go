@@ -0,0 +1,152 @@
+// Copyright (c) 2025 Steve Taranto <staranto@gmail.com>.
+// SPDX-License-Identifier: Apache-2.0
+
+package rules
+
+import (
+	"flag"
+	"fmt"
+	"testing"
+
+	"os"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+var sensitiveNamesDeep = flag.Bool("sensitiveNamesDeep", false, "enable deep assert")
+
+func TestSensitiveNamesRule(t *testing.T) {
+	flag.Parse()
+
+	content, _ := os.ReadFile("testdata/sensitive_names_test.tf")
+
+	cases := []struct {
+		Name    string
+		Config  string
+		Content string
+		Want    helper.Issues
+	}{
+		{
+			Name:    "sensitive_names",
+			Content: string(content),
+			Want: helper.Issues{
+				{
+					Rule:    NewSensitiveNamesRule(),
+					Message: makeSensitiveNameMessage("db_password", "password"),
+					Range: hcl.Range{
+						Filename: "sensitive_names_test.tf",
+						Start:    hcl.Pos{Line: 4, Column: 1},
+						End:      hcl.Pos{Line: 4, Column: 23},
+					},
+				},
+				{
+					Rule:    NewSensitiveNamesRule(),
+					Message: makeSensitiveNameMessage("github_api_key", "api_key"),
+					Range: hcl.Range{
+						Filename: "sensitive_names_test.tf",
+						Start:    hcl.Pos{Line: 10, Column: 15},
+						End:      hcl.Pos{Line: 10, Column: 20},
+					},
+				},
+				{
+					Rule:    NewSensitiveNamesRule(),
+					Message: makeSensitiveNameMessage("accessToken", "token"),
+					Range: hcl.Range{
+						Filename: "sensitive_names_test.tf",
+						Start:    hcl.Pos{Line: 13, Column: 1},
+						End:      hcl.Pos{Line: 13, Column: 21},
+					},
+				},
+				{
+					Rule:    NewSensitiveNamesRule(),
+					Message: makeSensitiveReferenceMessage("database_url", "connection_string"),
+					Range: hcl.Range{
+						Filename: "sensitive_names_test.tf",
+						Start:    hcl.Pos{Line: 22, Column: 1},
+						End:      hcl.Pos{Line: 22, Column: 22},
+					},
+				},
+			},
+		},
+		{
+			Name: "tokens",
+			Config: `
+rule "eos_sensitive_names" {
+  enabled = true
+  tokens  = ["key"]
+}`,
+			Content: string(content),
+			Want: helper.Issues{
+				{
+					Rule:    NewSensitiveNamesRule(),
+					Message: makeSensitiveNameMessage("github_api_key", "key"),
+					Range: hcl.Range{
+						Filename: "sensitive_names_test.tf",
+						Start:    hcl.Pos{Line: 10, Column: 15},
+						End:      hcl.Pos{Line: 10, Column: 20},
+					},
+				},
+				{
+					Rule:    NewSensitiveNamesRule(),
+					Message: makeSensitiveReferenceMessage("database_url", "connection_string"),
+					Range: hcl.Range{
+						Filename: "sensitive_names_test.tf",
+						Start:    hcl.Pos{Line: 22, Column: 1},
+						End:      hcl.Pos{Line: 22, Column: 22},
+					},
+				},
+				{
+					Rule:    NewSensitiveNamesRule(),
+					Message: makeSensitiveNameMessage("key_name", "key"),
+					Range: hcl.Range{
+						Filename: "sensitive_names_test.tf",
+						Start:    hcl.Pos{Line: 39, Column: 1},
+						End:      hcl.Pos{Line: 39, Column: 20},
+					},
+				},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+
+		// Run the tests and make sure the basic results are found...
+		files := map[string]string{"sensitive_names_test.tf": tc.Content}
+		if tc.Config != "" {
+			files[".tflint.hcl"] = tc.Config
+		}
+		runner := helper.TestRunner(t, files)
+		rule := NewSensitiveNamesRule()
+
+		// ... no errors.
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		// ... and the expected number of issues.
+		if len(runner.Issues) != len(tc.Want) {
+			t.Logf("Expected %d issues, got %d", len(tc.Want), len(runner.Issues))
+			for i, issue := range runner.Issues {
+				t.Logf("Issue %d: %s at %s", i, issue.Message, issue.Range)
+			}
+			t.Fatalf("Number of issues mismatch: got %d, want %d", len(runner.Issues), len(tc.Want))
+		}
+
+		t.Run(tc.Name, func(t *testing.T) {
+			if *sensitiveNamesDeep {
+				helper.AssertIssues(t, tc.Want, runner.Issues)
+			} else {
+				helper.AssertIssuesWithoutRange(t, tc.Want, runner.Issues)
+			}
+		})
+	}
+}
+
+func makeSensitiveNameMessage(name string, token string) string {
+	return fmt.Sprintf("'%s' contains '%s' but is not marked sensitive.", name, token)
+}
+
+func makeSensitiveReferenceMessage(name string, variable string) string {
+	return fmt.Sprintf("'%s' exposes the sensitive variable '%s' but is not marked sensitive.", name, variable)
+}
@@ -26,6 +26,9 @@ var defaultShoutConfig = shoutRuleConfig{
 type ShoutRule struct {
 	tflint.DefaultRule
 	Config shoutRuleConfig
+
+	// names indexes the module's names for suggestions.
+	names nameIndex
 }
 
 // Check checks whether the rule conditions are met.
@@ -34,15 +37,33 @@ func (r *ShoutRule) Check(runner tflint.Runner) error {
 		return err
 	}
 
+	names, err := indexNames(runner, allLintableBlocks)
+	if err != nil {
+		return err
+	}
+	r.names = names
+
 	return CheckBlocksAndLocals(runner, allLintableBlocks, r, checkForShout)
 }
 
 // checkForShout checks if the name is shouted.
-func checkForShout(runner tflint.Runner, r *ShoutRule, block *hclext.Block, _ string, name string, _ string) {
+func checkForShout(runner tflint.Runner, r *ShoutRule, block *hclext.Block, typ string, name string, _ string) {
+	if isShouted(name) {
+		message := withSuggestion(fmt.Sprintf("'%s' should not be all uppercase.", name),
+			suggestName(name, nameKind(block, typ), r.names, keepWord))
+		if err := runner.EmitIssue(r, message, block.DefRange); err != nil {
+			logger.Error(err.Error())
+		}
+		logger.Debug(message)
+	}
+}
+
+// isShouted reports whether s has letters and all of them are uppercase.
+func isShouted(s string) bool {
 	hasAlpha := false
 	allUpper := true
 
-	for _, ch := range name {
+	for _, ch := range s {
 		if unicode.IsLetter(ch) {
 			hasAlpha = true
 			if !unicode.IsUpper(ch) {
@@ -51,13 +72,7 @@ func checkForShout(runner tflint.Runner, r *ShoutRule, block *hclext.Block, _ st
 		}
 	}
 
-	if hasAlpha && allUpper {
-		message := fmt.Sprintf("'%s' should not be all uppercase.", name)
-		if err := runner.EmitIssue(r, message, block.DefRange); err != nil {
-			logger.Error(err.Error())
-		}
-		logger.Debug(message)
-	}
+	return hasAlpha && allUpper
 }
 
 // NewShoutRule returns a new rule.
@@ -34,7 +34,7 @@ func TestShoutRule(t *testing.T) {
 			Want: helper.Issues{
 				{
 					Rule:    NewShoutRule(),
-					Message: makeShoutMessage(shoutName),
+					Message: makeShoutMessage(shoutName, "shout"),
 					Range: hcl.Range{
 						Filename: "shout_test.tf",
 						Start:    hcl.Pos{Line: 7, Column: 1},
@@ -43,7 +43,7 @@ func TestShoutRule(t *testing.T) {
 				},
 				{
 					Rule:    NewShoutRule(),
-					Message: makeShoutMessage(shoutName),
+					Message: makeShoutMessage(shoutName, "shout"),
 					Range: hcl.Range{
 						Filename: "shout_test.tf",
 						Start:    hcl.Pos{Line: 10, Column: 3},
@@ -52,7 +52,7 @@ func TestShoutRule(t *testing.T) {
 				},
 				{
 					Rule:    NewShoutRule(),
-					Message: makeShoutMessage(shoutName),
+					Message: makeShoutMessage(shoutName, "shout"),
 					Range: hcl.Range{
 						Filename: "shout_test.tf",
 						Start:    hcl.Pos{Line: 13, Column: 1},
@@ -61,7 +61,7 @@ func TestShoutRule(t *testing.T) {
 				},
 				{
 					Rule:    NewShoutRule(),
-					Message: makeShoutMessage(shoutName),
+					Message: makeShoutMessage(shoutName, "shout"),
 					Range: hcl.Range{
 						Filename: "shout_test.tf",
 						Start:    hcl.Pos{Line: 20, Column: 1},
@@ -70,7 +70,7 @@ func TestShoutRule(t *testing.T) {
 				},
 				{
 					Rule:    NewShoutRule(),
-					Message: makeShoutMessage(shoutName),
+					Message: makeShoutMessage(shoutName, "shout"),
 					Range: hcl.Range{
 						Filename: "shout_test.tf",
 						Start:    hcl.Pos{Line: 22, Column: 1},
@@ -79,7 +79,7 @@ func TestShoutRule(t *testing.T) {
 				},
 				{
 					Rule:    NewShoutRule(),
-					Message: makeShoutMessage(shoutName),
+					Message: makeShoutMessage(shoutName, "shout"),
 					Range: hcl.Range{
 						Filename: "shout_test.tf",
 						Start:    hcl.Pos{Line: 26, Column: 1},
@@ -88,7 +88,7 @@ func TestShoutRule(t *testing.T) {
 				},
 				{
 					Rule:    NewShoutRule(),
-					Message: makeShoutMessage(shoutName),
+					Message: makeShoutMessage(shoutName, "shout"),
 					Range: hcl.Range{
 						Filename: "shout_test.tf",
 						Start:    hcl.Pos{Line: 30, Column: 1},
@@ -97,7 +97,7 @@ func TestShoutRule(t *testing.T) {
 				},
 				{
 					Rule:    NewShoutRule(),
-					Message: makeShoutMessage(shoutName),
+					Message: makeShoutMessage(shoutName, "shout"),
 					Range: hcl.Range{
 						Filename: "shout_test.tf",
 						Start:    hcl.Pos{Line: 35, Column: 1},
@@ -134,6 +134,6 @@ func TestShoutRule(t *testing.T) {
 	}
 }
 
-func makeShoutMessage(name string) string {
-	return fmt.Sprintf("'%s' should not be all uppercase.", name)
+func makeShoutMessage(name string, suggestion string) string {
+	return fmt.Sprintf("'%s' should not be all uppercase. Consider '%s'.", name, suggestion)
 }
@@ -0,0 +1,140 @@
+// Copyright (c) 2025 Steve Taranto <staranto@gmail.com>.
+// SPDX-License-Identifier: Apache-2.0
+
+package rules
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/agext/levenshtein"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/logger"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+var defaultSimilarNamesConfig = similarNamesRuleConfig{
+	Level:     "warning",
+	MinLength: 4,
+	Threshold: 2,
+}
+
+// similarNamesRuleConfig represents the configuration for the
+// SimilarNamesRule.
+type similarNamesRuleConfig struct {
+	Level     string `hclext:"level,optional"`
+	MinLength int    `hclext:"min_length,optional"`
+	Threshold int    `hclext:"threshold,optional"`
+}
+
+// kindedName is a name with the block it was declared by.
+type kindedName struct {
+	block *hclext.Block
+	name  string
+}
+
+// SimilarNamesRule checks for names of the same kind that are easily
+// confused with each other.
+type SimilarNamesRule struct {
+	tflint.DefaultRule
+	Config similarNamesRuleConfig
+
+	// kinds collects the names by block type and type, and keys records the
+	// order in which the kinds were found.
+	kinds map[string][]kindedName
+	keys  []string
+}
+
+// Check checks whether the rule conditions are met.
+func (r *SimilarNamesRule) Check(runner tflint.Runner) error {
+	if err := runner.DecodeRuleConfig(r.Name(), &r.Config); err != nil {
+		return err
+	}
+
+	r.kinds, r.keys = map[string][]kindedName{}, nil
+	if err := CheckBlocksAndLocals(runner, allLintableBlocks, r, collectKindedName); err != nil {
+		return err
+	}
+
+	for _, key := range r.keys {
+		names := r.kinds[key]
+		// Locals come from a map, so put everything in source order.
+		slices.SortFunc(names, func(a, b kindedName) int {
+			return cmp.Or(
+				strings.Compare(a.block.DefRange.Filename, b.block.DefRange.Filename),
+				a.block.DefRange.Start.Byte-b.block.DefRange.Start.Byte,
+			)
+		})
+
+		for j := range names {
+			for i := range j {
+				if !r.similar(names[i].name, names[j].name) {
+					continue
+				}
+
+				message := fmt.Sprintf("'%s' is easily confused with '%s'.", names[j].name, names[i].name)
+				if err := runner.EmitIssue(r, message, names[j].block.DefRange); err != nil {
+					logger.Error(err.Error())
+				}
+				logger.Debug(message)
+			}
+		}
+	}
+
+	return nil
+}
+
+// similar reports whether two names are within the threshold. Short names and
+// numbered siblings, which eos_numbered_names covers, are never similar.
+func (r *SimilarNamesRule) similar(a string, b string) bool {
+	if len(a) < r.Config.MinLength || len(b) < r.Config.MinLength {
+		return false
+	}
+
+	matchA := numberedNameParser.FindStringSubmatch(a)
+	matchB := numberedNameParser.FindStringSubmatch(b)
+	if matchA != nil && matchB != nil && strings.EqualFold(matchA[1], matchB[1]) {
+		return false
+	}
+
+	return levenshtein.Distance(a, b, nil) < r.Config.Threshold
+}
+
+// collectKindedName adds the name to its kind. Resources and data sources of
+// the same type are different kinds.
+func collectKindedName(_ tflint.Runner, r *SimilarNamesRule, block *hclext.Block, typ string, name string, _ string) {
+	key := nameKind(block, typ)
+	if _, ok := r.kinds[key]; !ok {
+		r.keys = append(r.keys, key)
+	}
+	r.kinds[key] = append(r.kinds[key], kindedName{block: block, name: name})
+}
+
+// NewSimilarNamesRule returns a new rule.
+func NewSimilarNamesRule() *SimilarNamesRule {
+	rule := &SimilarNamesRule{}
+	rule.Config = defaultSimilarNamesConfig
+	return rule
+}
+
+// Enabled returns whether the rule is enabled by default.
+func (r *SimilarNamesRule) Enabled() bool {
+	return true
+}
+
+// Link returns the rule reference link.
+func (r *SimilarNamesRule) Link() string {
+	return "https://github.com/staranto/tflint-ruleset-elements-of-style/blob/main/docs/rules/eos_similar_names.md"
+}
+
+// Name returns the rule name.
+func (r *SimilarNamesRule) Name() string {
+	return "eos_similar_names"
+}
+
+// Severity returns the rule severity.
+func (r *SimilarNamesRule) Severity() tflint.Severity {
+	return toSeverity(r.Config.Level)
+}
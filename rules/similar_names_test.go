@@ -0,0 +1,131 @@
+// Copyright (c) 2025 Steve Taranto <staranto@gmail.com>.
+// SPDX-License-Identifier: Apache-2.0
+
+package rules
+
+import (
+	"flag"
+	"fmt"
+	"testing"
+
+	"os"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+var similarNamesDeep = flag.Bool("similarNamesDeep", false, "enable deep assert")
+
+func TestSimilarNamesRule(t *testing.T) {
+	flag.Parse()
+
+	content, _ := os.ReadFile("testdata/similar_names_test.tf")
+
+	cases := []struct {
+		Name    string
+		Config  string
+		Content string
+		Want    helper.Issues
+	}{
+		{
+			Name:    "similar_names",
+			Content: string(content),
+			Want: helper.Issues{
+				{
+					Rule:    NewSimilarNamesRule(),
+					Message: makeSimilarNamesMessage("subnets_id", "subnet_id"),
+					Range: hcl.Range{
+						Filename: "similar_names_test.tf",
+						Start:    hcl.Pos{Line: 6, Column: 1},
+						End:      hcl.Pos{Line: 6, Column: 22},
+					},
+				},
+				{
+					Rule:    NewSimilarNamesRule(),
+					Message: makeSimilarNamesMessage("bucket-name", "bucket_name"),
+					Range: hcl.Range{
+						Filename: "similar_names_test.tf",
+						Start:    hcl.Pos{Line: 10, Column: 3},
+						End:      hcl.Pos{Line: 10, Column: 26},
+					},
+				},
+			},
+		},
+		{
+			Name: "threshold",
+			Config: `
+rule "eos_similar_names" {
+  enabled    = true
+  threshold  = 4
+  min_length = 3
+}`,
+			Content: string(content),
+			Want: helper.Issues{
+				{
+					Rule:    NewSimilarNamesRule(),
+					Message: makeSimilarNamesMessage("subnets_id", "subnet_id"),
+					Range: hcl.Range{
+						Filename: "similar_names_test.tf",
+						Start:    hcl.Pos{Line: 6, Column: 1},
+						End:      hcl.Pos{Line: 6, Column: 22},
+					},
+				},
+				{
+					Rule:    NewSimilarNamesRule(),
+					Message: makeSimilarNamesMessage("bucket-name", "bucket_name"),
+					Range: hcl.Range{
+						Filename: "similar_names_test.tf",
+						Start:    hcl.Pos{Line: 10, Column: 3},
+						End:      hcl.Pos{Line: 10, Column: 26},
+					},
+				},
+				{
+					Rule:    NewSimilarNamesRule(),
+					Message: makeSimilarNamesMessage("log", "logs"),
+					Range: hcl.Range{
+						Filename: "similar_names_test.tf",
+						Start:    hcl.Pos{Line: 20, Column: 1},
+						End:      hcl.Pos{Line: 20, Column: 31},
+					},
+				},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+
+		// Run the tests and make sure the basic results are found...
+		files := map[string]string{"similar_names_test.tf": tc.Content}
+		if tc.Config != "" {
+			files[".tflint.hcl"] = tc.Config
+		}
+		runner := helper.TestRunner(t, files)
+		rule := NewSimilarNamesRule()
+
+		// ... no errors.
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		// ... and the expected number of issues.
+		if len(runner.Issues) != len(tc.Want) {
+			t.Logf("Expected %d issues, got %d", len(tc.Want), len(runner.Issues))
+			for i, issue := range runner.Issues {
+				t.Logf("Issue %d: %s at %s", i, issue.Message, issue.Range)
+			}
+			t.Fatalf("Number of issues mismatch: got %d, want %d", len(runner.Issues), len(tc.Want))
+		}
+
+		t.Run(tc.Name, func(t *testing.T) {
+			if *similarNamesDeep {
+				helper.AssertIssues(t, tc.Want, runner.Issues)
+			} else {
+				helper.AssertIssuesWithoutRange(t, tc.Want, runner.Issues)
+			}
+		})
+	}
+}
+
+func makeSimilarNamesMessage(name string, other string) string {
+	return fmt.Sprintf("'%s' is easily confused with '%s'.", name, other)
+}
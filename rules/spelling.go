@@ -0,0 +1,399 @@
+// Copyright (c) 2025 Steve Taranto <staranto@gmail.com>.
+// SPDX-License-Identifier: Apache-2.0
+
+package rules
+
+import (
+	_ "embed"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/logger"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+//go:embed dictionary/english.txt
+var englishWords string
+
+//go:embed dictionary/terraform.txt
+var terraformWords string
+
+// builtinDictionary holds the embedded English and Terraform vocabulary.
+var builtinDictionary = parseDictionary(englishWords + "\n" + terraformWords)
+
+// inflections are the suffixes that are stripped, and replaced, to find the
+// base form of a word in the dictionary.
+var inflections = []struct {
+	suffix  string
+	replace string
+}{
+	{"s", ""}, {"es", ""}, {"ies", "y"},
+	{"d", ""}, {"ed", ""}, {"ied", "y"},
+	{"ing", ""}, {"ing", "e"},
+	{"er", ""}, {"er", "e"}, {"ers", ""}, {"ers", "e"},
+	{"ly", ""}, {"ily", "y"},
+}
+
+var defaultSpellingConfig = spellingRuleConfig{
+	Comments:     true,
+	Descriptions: true,
+	Level:        "warning",
+	MinLength:    4,
+}
+
+// spellingRuleConfig represents the configuration for the SpellingRule.
+type spellingRuleConfig struct {
+	Comments     bool   `hclext:"comments,optional"`
+	Descriptions bool   `hclext:"descriptions,optional"`
+	Dictionary   string `hclext:"dictionary,optional"`
+	Level        string `hclext:"level,optional"`
+	MinLength    int    `hclext:"min_length,optional"`
+}
+
+// SpellingRule checks names, descriptions and comments for misspelled words.
+type SpellingRule struct {
+	tflint.DefaultRule
+	Config spellingRuleConfig
+
+	// dictionary holds the known words, including the project dictionary.
+	dictionary map[string]bool
+	// words holds the dictionary sorted, so that suggestions are stable.
+	words []string
+	// names indexes the module's names for suggestions.
+	names nameIndex
+}
+
+// Check checks whether the rule conditions are met.
+func (r *SpellingRule) Check(runner tflint.Runner) error {
+	if err := runner.DecodeRuleConfig(r.Name(), &r.Config); err != nil {
+		return err
+	}
+
+	if err := r.loadDictionary(runner); err != nil {
+		return err
+	}
+
+	names, err := indexNames(runner, allLintableBlocks)
+	if err != nil {
+		return err
+	}
+	r.names = names
+
+	if err := CheckBlocksAndLocals(runner, allLintableBlocks, r, checkForSpelling); err != nil {
+		return err
+	}
+
+	if r.Config.Descriptions {
+		if err := r.checkDescriptions(runner); err != nil {
+			return err
+		}
+	}
+
+	if r.Config.Comments {
+		if err := r.checkComments(runner); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// loadDictionary merges the project dictionary, if any, over the builtin one.
+// A relative path is resolved against the directory tflint was started in.
+func (r *SpellingRule) loadDictionary(runner tflint.Runner) error {
+	r.dictionary = builtinDictionary
+	if r.Config.Dictionary != "" {
+		path := r.Config.Dictionary
+		if !filepath.IsAbs(path) {
+			wd, err := runner.GetOriginalwd()
+			if err != nil {
+				return err
+			}
+			path = filepath.Join(wd, path)
+		}
+
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("unable to read dictionary '%s': %w", r.Config.Dictionary, err)
+		}
+
+		r.dictionary = parseDictionary(string(content))
+		for word := range builtinDictionary {
+			r.dictionary[word] = true
+		}
+	}
+
+	r.words = make([]string, 0, len(r.dictionary))
+	for word := range r.dictionary {
+		r.words = append(r.words, word)
+	}
+	slices.Sort(r.words)
+
+	return nil
+}
+
+// checkForSpelling checks each word of the name.
+func checkForSpelling(runner tflint.Runner, r *SpellingRule, block *hclext.Block, typ string, name string, _ string) {
+	for _, word := range tokenizeName(name) {
+		correction := r.correct(word)
+		if correction == "" {
+			continue
+		}
+
+		message := fmt.Sprintf("'%s' in '%s' may be a misspelling of '%s'.", word, name, correction)
+		message = withSuggestion(message, suggestName(name, nameKind(block, typ), r.names, func(w string) string {
+			if c := r.correct(w); c != "" {
+				return c
+			}
+			return w
+		}))
+		if err := runner.EmitIssue(r, message, block.DefRange); err != nil {
+			logger.Error(err.Error())
+		}
+		logger.Debug(message)
+	}
+}
+
+// checkDescriptions checks the description of variables and outputs.
+func (r *SpellingRule) checkDescriptions(runner tflint.Runner) error {
+	content, err := runner.GetModuleContent(&hclext.BodySchema{
+		Blocks: buildBlockSchemas(describedBlocks),
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, block := range content.Blocks {
+		attr, ok := block.Body.Attributes["description"]
+		if !ok {
+			continue
+		}
+
+		err := runner.EvaluateExpr(attr.Expr, func(description string) error {
+			r.checkProse(runner, description, attr.Expr.Range())
+			return nil
+		}, nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// checkComments checks the comments of the root module. Like eos_comments,
+// child modules are skipped since their authors are not the ones reading.
+func (r *SpellingRule) checkComments(runner tflint.Runner) error {
+	path, err := runner.GetModulePath()
+	if err != nil {
+		return err
+	}
+	if !path.IsRoot() {
+		return nil
+	}
+
+	files, err := runner.GetFiles()
+	if err != nil {
+		return err
+	}
+	for name, file := range files {
+		tokens, err := commentTokens(name, file)
+		if err != nil {
+			return err
+		}
+		for _, token := range tokens {
+			text := string(token.Bytes)
+			// Annotations are directives, not prose.
+			if strings.Contains(text, "tflint-ignore") {
+				continue
+			}
+			r.checkProse(runner, text, token.Range)
+		}
+	}
+
+	return nil
+}
+
+// checkProse checks the words of a description or comment.
+func (r *SpellingRule) checkProse(runner tflint.Runner, text string, rng hcl.Range) {
+	for _, word := range proseWords(text) {
+		correction := r.correct(word)
+		if correction == "" {
+			continue
+		}
+
+		message := fmt.Sprintf("'%s' may be a misspelling of '%s'.", word, correction)
+		if err := runner.EmitIssue(r, message, rng); err != nil {
+			logger.Error(err.Error())
+		}
+		logger.Debug(message)
+	}
+}
+
+// correct returns the dictionary word closest to an unknown word. It returns
+// "" if the word is known, too short to check, or not close to any word; the
+// latter are taken to be names and jargon rather than misspellings.
+func (r *SpellingRule) correct(word string) string {
+	if len(word) < r.Config.MinLength || strings.IndexFunc(word, func(c rune) bool { return c < 'a' || c > 'z' }) >= 0 {
+		return ""
+	}
+	if r.known(word) {
+		return ""
+	}
+
+	// Longer words can afford a second typo.
+	limit := 1
+	if len(word) > 5 {
+		limit = 2
+	}
+
+	// Ties go to a word with the same first letter, which typos rarely touch.
+	best, bestDistance, bestFirst := "", limit+1, false
+	for _, candidate := range r.words {
+		if diff := len(candidate) - len(word); diff > limit || diff < -limit {
+			continue
+		}
+		distance := editDistance(word, candidate)
+		first := candidate[0] == word[0]
+		if distance < bestDistance || (distance == bestDistance && first && !bestFirst) {
+			best, bestDistance, bestFirst = candidate, distance, first
+		}
+	}
+
+	return best
+}
+
+// editDistance returns the number of insertions, deletions, substitutions
+// and transpositions of adjacent letters needed to turn a into b.
+func editDistance(a string, b string) int {
+	rows := make([][]int, len(a)+1)
+	for i := range rows {
+		rows[i] = make([]int, len(b)+1)
+		rows[i][0] = i
+	}
+	for j := range rows[0] {
+		rows[0][j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			rows[i][j] = min(rows[i-1][j]+1, rows[i][j-1]+1, rows[i-1][j-1]+cost)
+			if i > 1 && j > 1 && a[i-1] == b[j-2] && a[i-2] == b[j-1] {
+				rows[i][j] = min(rows[i][j], rows[i-2][j-2]+1)
+			}
+		}
+	}
+
+	return rows[len(a)][len(b)]
+}
+
+// known reports whether a word, or its base form, is in the dictionary.
+func (r *SpellingRule) known(word string) bool {
+	if r.dictionary[word] {
+		return true
+	}
+
+	for _, inflection := range inflections {
+		stem, ok := strings.CutSuffix(word, inflection.suffix)
+		if !ok || len(stem) < 2 {
+			continue
+		}
+		if r.dictionary[stem+inflection.replace] {
+			return true
+		}
+		// A doubled consonant, as in "running" or "stopped".
+		if inflection.replace == "" && stem[len(stem)-1] == stem[len(stem)-2] && r.dictionary[stem[:len(stem)-1]] {
+			return true
+		}
+	}
+
+	return false
+}
+
+// proseWords returns the lowercase words of a description or comment. Fields
+// that look like code (addresses, paths, URLs, camelCase or ACRONYMS) are
+// skipped, and contractions are reduced to their first word.
+func proseWords(text string) []string {
+	var words []string
+	for _, field := range strings.Fields(text) {
+		field = strings.TrimFunc(field, func(c rune) bool {
+			return !unicode.IsLetter(c)
+		})
+		if field == "" {
+			continue
+		}
+
+		if before, after, ok := strings.Cut(field, "'"); ok {
+			field = before
+			// don't -> do, isn't -> is
+			if after == "t" {
+				field = strings.TrimSuffix(field, "n")
+			}
+		}
+
+		for _, part := range strings.Split(field, "-") {
+			if part == "" || strings.IndexFunc(part, func(c rune) bool { return !unicode.IsLetter(c) }) >= 0 {
+				continue
+			}
+			if strings.IndexFunc(part[1:], unicode.IsUpper) >= 0 {
+				continue
+			}
+			words = append(words, strings.ToLower(part))
+		}
+	}
+	return words
+}
+
+// parseDictionary parses a dictionary file. Words are separated by white
+// space and lines starting with '#' are comments.
+func parseDictionary(content string) map[string]bool {
+	dictionary := map[string]bool{}
+	for _, line := range strings.Split(content, "\n") {
+		line = strings.TrimSpace(line)
+		if strings.HasPrefix(line, "#") {
+			continue
+		}
+		for _, word := range strings.Fields(line) {
+			dictionary[strings.ToLower(word)] = true
+		}
+	}
+	return dictionary
+}
+
+// NewSpellingRule returns a new rule.
+func NewSpellingRule() *SpellingRule {
+	rule := &SpellingRule{}
+	rule.Config = defaultSpellingConfig
+	return rule
+}
+
+// Enabled returns whether the rule is enabled by default. A dictionary can
+// never know every name in a project, so the rule is opt-in.
+func (r *SpellingRule) Enabled() bool {
+	return false
+}
+
+// Link returns the rule reference link.
+func (r *SpellingRule) Link() string {
+	return "https://github.com/staranto/tflint-ruleset-elements-of-style/blob/main/docs/rules/eos_spelling.md"
+}
+
+// Name returns the rule name.
+func (r *SpellingRule) Name() string {
+	return "eos_spelling"
+}
+
+// Severity returns the rule severity.
+func (r *SpellingRule) Severity() tflint.Severity {
+	return toSeverity(r.Config.Level)
+}
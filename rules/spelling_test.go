@@ -0,0 +1,182 @@
+// Copyright (c) 2025 Steve Taranto <staranto@gmail.com>.
+// SPDX-License-Identifier: Apache-2.0
+
+package rules
+
+import (
+	"flag"
+	"fmt"
+	"testing"
+
+	"os"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+var spellingDeep = flag.Bool("spellingDeep", false, "enable deep assert")
+
+func TestSpellingRule(t *testing.T) {
+	flag.Parse()
+
+	content, _ := os.ReadFile("testdata/spelling_test.tf")
+
+	cases := []struct {
+		Name    string
+		Config  string
+		Content string
+		Want    helper.Issues
+	}{
+		{
+			Name:    "spelling",
+			Content: string(content),
+			Want: helper.Issues{
+				{
+					Rule:    NewSpellingRule(),
+					Message: makeSpellingMessage("aplication", "application"),
+					Range: hcl.Range{
+						Filename: "spelling_test.tf",
+						Start:    hcl.Pos{Line: 4, Column: 1},
+						End:      hcl.Pos{Line: 5, Column: 1},
+					},
+				},
+				{
+					Rule:    NewSpellingRule(),
+					Message: makeNameSpellingMessage("bukcet", "log_bukcet", "bucket", "log_bucket"),
+					Range: hcl.Range{
+						Filename: "spelling_test.tf",
+						Start:    hcl.Pos{Line: 5, Column: 1},
+						End:      hcl.Pos{Line: 5, Column: 38},
+					},
+				},
+				{
+					Rule:    NewSpellingRule(),
+					Message: makeSpellingMessage("retian", "retain"),
+					Range: hcl.Range{
+						Filename: "spelling_test.tf",
+						Start:    hcl.Pos{Line: 10, Column: 17},
+						End:      hcl.Pos{Line: 10, Column: 53},
+					},
+				},
+				{
+					Rule:    NewSpellingRule(),
+					Message: makeNameSpellingMessage("replicaton", "replicaton_factor", "replication", "replication_factor"),
+					Range: hcl.Range{
+						Filename: "spelling_test.tf",
+						Start:    hcl.Pos{Line: 15, Column: 3},
+						End:      hcl.Pos{Line: 15, Column: 24},
+					},
+				},
+				{
+					Rule:    NewSpellingRule(),
+					Message: makeNameSpellingMessage("logz", "logz_token", "log", "log_token"),
+					Range: hcl.Range{
+						Filename: "spelling_test.tf",
+						Start:    hcl.Pos{Line: 18, Column: 1},
+						End:      hcl.Pos{Line: 18, Column: 22},
+					},
+				},
+			},
+		},
+		{
+			Name: "dictionary",
+			Config: `
+rule "eos_spelling" {
+  enabled    = true
+  comments   = false
+  dictionary = "testdata/spelling_dictionary.txt"
+}`,
+			Content: string(content),
+			Want: helper.Issues{
+				{
+					Rule:    NewSpellingRule(),
+					Message: makeNameSpellingMessage("bukcet", "log_bukcet", "bucket", "log_bucket"),
+					Range: hcl.Range{
+						Filename: "spelling_test.tf",
+						Start:    hcl.Pos{Line: 5, Column: 1},
+						End:      hcl.Pos{Line: 5, Column: 38},
+					},
+				},
+				{
+					Rule:    NewSpellingRule(),
+					Message: makeSpellingMessage("retian", "retain"),
+					Range: hcl.Range{
+						Filename: "spelling_test.tf",
+						Start:    hcl.Pos{Line: 10, Column: 17},
+						End:      hcl.Pos{Line: 10, Column: 53},
+					},
+				},
+				{
+					Rule:    NewSpellingRule(),
+					Message: makeNameSpellingMessage("replicaton", "replicaton_factor", "replication", "replication_factor"),
+					Range: hcl.Range{
+						Filename: "spelling_test.tf",
+						Start:    hcl.Pos{Line: 15, Column: 3},
+						End:      hcl.Pos{Line: 15, Column: 24},
+					},
+				},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+
+		// Run the tests and make sure the basic results are found...
+		files := map[string]string{"spelling_test.tf": tc.Content}
+		if tc.Config != "" {
+			files[".tflint.hcl"] = tc.Config
+		}
+		runner := helper.TestRunner(t, files)
+		rule := NewSpellingRule()
+
+		// ... no errors.
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		// ... and the expected number of issues.
+		if len(runner.Issues) != len(tc.Want) {
+			t.Logf("Expected %d issues, got %d", len(tc.Want), len(runner.Issues))
+			for i, issue := range runner.Issues {
+				t.Logf("Issue %d: %s at %s", i, issue.Message, issue.Range)
+			}
+			t.Fatalf("Number of issues mismatch: got %d, want %d", len(runner.Issues), len(tc.Want))
+		}
+
+		t.Run(tc.Name, func(t *testing.T) {
+			if *spellingDeep {
+				helper.AssertIssues(t, tc.Want, runner.Issues)
+			} else {
+				helper.AssertIssuesWithoutRange(t, tc.Want, runner.Issues)
+			}
+		})
+	}
+}
+
+func TestEditDistance(t *testing.T) {
+	cases := []struct {
+		A    string
+		B    string
+		Want int
+	}{
+		{A: "bucket", B: "bucket", Want: 0},
+		{A: "bukcet", B: "bucket", Want: 1},
+		{A: "aplication", B: "application", Want: 1},
+		{A: "retian", B: "region", Want: 2},
+		{A: "", B: "log", Want: 3},
+	}
+
+	for _, tc := range cases {
+		if got := editDistance(tc.A, tc.B); got != tc.Want {
+			t.Errorf("editDistance(%q, %q) = %d, want %d", tc.A, tc.B, got, tc.Want)
+		}
+	}
+}
+
+func makeSpellingMessage(word string, correction string) string {
+	return fmt.Sprintf("'%s' may be a misspelling of '%s'.", word, correction)
+}
+
+func makeNameSpellingMessage(word string, name string, correction string, suggestion string) string {
+	return fmt.Sprintf("'%s' in '%s' may be a misspelling of '%s'. Consider '%s'.", word, name, correction, suggestion)
+}
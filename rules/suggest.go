@@ -0,0 +1,89 @@
+// Copyright (c) 2025 Steve Taranto <staranto@gmail.com>.
+// SPDX-License-Identifier: Apache-2.0
+
+package rules
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// nameIndex records the names in use in a module, keyed by their kind.
+type nameIndex map[string]map[string]bool
+
+// nameKind returns the kind of a name, which is its block type and name type,
+// so that resources and data sources of the same type are different kinds.
+func nameKind(block *hclext.Block, typ string) string {
+	return block.Type + "." + typ
+}
+
+// indexNames collects the names of all blocks and locals in the module.
+func indexNames(runner tflint.Runner, myBlocks []BlockDef) (nameIndex, error) {
+	index := nameIndex{}
+	err := CheckBlocksAndLocals(runner, myBlocks, index, addToIndex)
+	return index, err
+}
+
+// addToIndex records a single name in the index.
+func addToIndex(_ tflint.Runner, index nameIndex, block *hclext.Block, typ string, name string, _ string) {
+	kind := nameKind(block, typ)
+	if index[kind] == nil {
+		index[kind] = map[string]bool{}
+	}
+	index[kind][name] = true
+}
+
+// suggestName proposes a replacement for name. Each word of the name is passed
+// through rewrite, which returns the word to keep or "" to drop it. The
+// remaining words are lowercased and rejoined with the name's own separator. A
+// numeric suffix is added if the result collides with another name of the same
+// kind. An empty result means there is nothing useful to suggest.
+func suggestName(name string, kind string, index nameIndex, rewrite func(string) string) string {
+	sep := nameSeparator(name)
+
+	var words []string
+	for _, word := range tokenizeName(name) {
+		if word = rewrite(word); word != "" {
+			words = append(words, strings.ToLower(word))
+		}
+	}
+	if len(words) == 0 {
+		return ""
+	}
+
+	base := strings.Join(words, sep)
+	suggestion := base
+	for n := 2; index[kind][suggestion] && suggestion != name; n++ {
+		suggestion = fmt.Sprintf("%s%s%d", base, sep, n)
+	}
+
+	if suggestion == name {
+		return ""
+	}
+	return suggestion
+}
+
+// nameSeparator returns the separator used by a name. Names using only dashes
+// are dashed, everything else uses Terraform's own '_'.
+func nameSeparator(name string) string {
+	if strings.Contains(name, "-") && !strings.Contains(name, "_") {
+		return "-"
+	}
+	return "_"
+}
+
+// withSuggestion appends a suggested name to an issue message.
+func withSuggestion(message string, suggestion string) string {
+	if suggestion == "" {
+		return message
+	}
+	return fmt.Sprintf("%s Consider '%s'.", message, suggestion)
+}
+
+// keepWord is a rewrite func for suggestName that keeps every word.
+func keepWord(word string) string {
+	return word
+}
@@ -0,0 +1,202 @@
+// Copyright (c) 2025 Steve Taranto <staranto@gmail.com>.
+// SPDX-License-Identifier: Apache-2.0
+
+package rules
+
+import (
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/terraform-linters/tflint-plugin-sdk/logger"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// tagKeyParser matches the keys that are checked. Keys with other characters,
+// like "kubernetes.io/cluster" or "aws:cloudformation", follow conventions
+// of their own.
+var tagKeyParser = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_-]*$`)
+
+var defaultTagKeysConfig = tagKeysRuleConfig{
+	Attributes: []string{"default_tags", "tags"},
+	Level:      "warning",
+	Style:      "pascal",
+}
+
+// tagKeysRuleConfig represents the configuration for the TagKeysRule.
+type tagKeysRuleConfig struct {
+	Attributes []string          `hclext:"attributes,optional"`
+	Level      string            `hclext:"level,optional"`
+	Style      string            `hclext:"style,optional"`
+	Styles     map[string]string `hclext:"styles,optional"`
+}
+
+// TagKeysRule checks whether the keys of tag maps follow the key style.
+type TagKeysRule struct {
+	tflint.DefaultRule
+	Config tagKeysRuleConfig
+
+	// fixable holds the attributes the user configured. Only their keys are
+	// fixed, since the defaults also meet maps, like Kubernetes labels, whose
+	// keys are matched elsewhere.
+	fixable []string
+}
+
+// Check checks whether the rule conditions are met.
+func (r *TagKeysRule) Check(runner tflint.Runner) error {
+	if err := runner.DecodeRuleConfig(r.Name(), &r.Config); err != nil {
+		return err
+	}
+
+	var configured tagKeysRuleConfig
+	if err := runner.DecodeRuleConfig(r.Name(), &configured); err != nil {
+		return err
+	}
+	r.fixable = append(configured.Attributes, slices.Collect(maps.Keys(configured.Styles))...)
+
+	for _, style := range append([]string{r.Config.Style}, slices.Collect(maps.Values(r.Config.Styles))...) {
+		if toCase("", style) == "?" {
+			return fmt.Errorf("unknown key style '%s'", style)
+		}
+	}
+
+	files, err := runner.GetFiles()
+	if err != nil {
+		return err
+	}
+	for _, file := range files {
+		// JSON files have no object constructors to check.
+		if body, ok := file.Body.(*hclsyntax.Body); ok {
+			r.checkBody(runner, body)
+		}
+	}
+
+	return nil
+}
+
+// checkBody checks the tag attributes of a body and of all its nested blocks,
+// such as default_tags within a provider.
+func (r *TagKeysRule) checkBody(runner tflint.Runner, body *hclsyntax.Body) {
+	for _, attr := range body.Attributes {
+		if style, ok := r.style(attr.Name); ok {
+			r.checkExpr(runner, attr.Expr, style, slices.Contains(r.fixable, attr.Name))
+		}
+	}
+	for _, block := range body.Blocks {
+		r.checkBody(runner, block.Body)
+	}
+}
+
+// style returns the key style of an attribute, and whether its keys are
+// checked at all.
+func (r *TagKeysRule) style(name string) (string, bool) {
+	if style, ok := r.Config.Styles[name]; ok {
+		return style, true
+	}
+	return r.Config.Style, slices.Contains(r.Config.Attributes, name)
+}
+
+// checkExpr checks the keys of an object constructor. The arguments of a
+// function call are checked too, so that merge(local.tags, { ... }) is.
+func (r *TagKeysRule) checkExpr(runner tflint.Runner, expr hclsyntax.Expression, style string, fixable bool) {
+	switch e := expr.(type) {
+	case *hclsyntax.FunctionCallExpr:
+		for _, arg := range e.Args {
+			r.checkExpr(runner, arg, style, fixable)
+		}
+	case *hclsyntax.ObjectConsExpr:
+		r.checkKeys(runner, e, style, fixable)
+	}
+}
+
+// checkKeys checks the literal keys of an object constructor.
+func (r *TagKeysRule) checkKeys(runner tflint.Runner, object *hclsyntax.ObjectConsExpr, style string, fixable bool) {
+	keys := map[string]bool{}
+	for _, item := range object.Items {
+		if key, _ := tagKey(item.KeyExpr); key != "" {
+			keys[key] = true
+		}
+	}
+
+	for _, item := range object.Items {
+		key, quoted := tagKey(item.KeyExpr)
+		if key == "" || !tagKeyParser.MatchString(key) {
+			continue
+		}
+
+		converted := toCase(key, style)
+		if converted == key || converted == "" {
+			continue
+		}
+
+		message := withSuggestion(fmt.Sprintf("'%s' does not follow the %s key style.", key, style), converted)
+		keyRange := item.KeyExpr.Range()
+		if err := runner.EmitIssueWithFix(r, message, keyRange, func(f tflint.Fixer) error {
+			// Renaming into an existing key would drop one of the values.
+			if !fixable || keys[converted] {
+				return tflint.ErrFixNotSupported
+			}
+			text := converted
+			if quoted {
+				text = fmt.Sprintf(`"%s"`, converted)
+			}
+			return f.ReplaceText(keyRange, text)
+		}); err != nil {
+			logger.Error(err.Error())
+		}
+		logger.Debug(message)
+	}
+}
+
+// tagKey returns the literal key of an object item and whether it is quoted.
+// It returns "" for keys computed from expressions.
+func tagKey(expr hclsyntax.Expression) (string, bool) {
+	keyExpr, ok := expr.(*hclsyntax.ObjectConsKeyExpr)
+	if !ok || keyExpr.ForceNonLiteral {
+		return "", false
+	}
+
+	if keyword := hcl.ExprAsKeyword(keyExpr.Wrapped); keyword != "" {
+		return keyword, false
+	}
+
+	template, ok := keyExpr.Wrapped.(*hclsyntax.TemplateExpr)
+	if !ok || !template.IsStringLiteral() {
+		return "", false
+	}
+	value, diags := template.Value(nil)
+	if diags.HasErrors() {
+		return "", false
+	}
+	return value.AsString(), true
+}
+
+// NewTagKeysRule returns a new rule.
+func NewTagKeysRule() *TagKeysRule {
+	rule := &TagKeysRule{}
+	rule.Config = defaultTagKeysConfig
+	return rule
+}
+
+// Enabled returns whether the rule is enabled by default.
+func (r *TagKeysRule) Enabled() bool {
+	return true
+}
+
+// Link returns the rule reference link.
+func (r *TagKeysRule) Link() string {
+	return "https://github.com/staranto/tflint-ruleset-elements-of-style/blob/main/docs/rules/eos_tag_keys.md"
+}
+
+// Name returns the rule name.
+func (r *TagKeysRule) Name() string {
+	return "eos_tag_keys"
+}
+
+// Severity returns the rule severity.
+func (r *TagKeysRule) Severity() tflint.Severity {
+	return toSeverity(r.Config.Level)
+}
@@ -0,0 +1,202 @@
+// Copyright (c) 2025 Steve Taranto <staranto@gmail.com>.
+// SPDX-License-Identifier: Apache-2.0
+
+package rules
+
+import (
+	"flag"
+	"fmt"
+	"testing"
+
+	"os"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+var tagKeysDeep = flag.Bool("tagKeysDeep", false, "enable deep assert")
+
+func TestTagKeysRule(t *testing.T) {
+	flag.Parse()
+
+	content, _ := os.ReadFile("testdata/tag_keys_test.tf")
+	fixed, _ := os.ReadFile("testdata/tag_keys_fixed_test.tf")
+	labels, _ := os.ReadFile("testdata/tag_keys_labels_test.tf")
+	labelsFixed, _ := os.ReadFile("testdata/tag_keys_labels_fixed_test.tf")
+
+	pascal := helper.Issues{
+		{
+			Rule:    NewTagKeysRule(),
+			Message: makeTagKeyMessage("cost_center", "pascal", "CostCenter"),
+			Range: hcl.Range{
+				Filename: "tag_keys_test.tf",
+				Start:    hcl.Pos{Line: 7, Column: 7},
+				End:      hcl.Pos{Line: 7, Column: 18},
+			},
+		},
+		{
+			Rule:    NewTagKeysRule(),
+			Message: makeTagKeyMessage("owner", "pascal", "Owner"),
+			Range: hcl.Range{
+				Filename: "tag_keys_test.tf",
+				Start:    hcl.Pos{Line: 16, Column: 5},
+				End:      hcl.Pos{Line: 16, Column: 12},
+			},
+		},
+		{
+			Rule:    NewTagKeysRule(),
+			Message: makeTagKeyMessage("managed-by", "pascal", "ManagedBy"),
+			Range: hcl.Range{
+				Filename: "tag_keys_test.tf",
+				Start:    hcl.Pos{Line: 22, Column: 5},
+				End:      hcl.Pos{Line: 22, Column: 15},
+			},
+		},
+	}
+
+	cases := []struct {
+		Name    string
+		Config  string
+		Content string
+		Want    helper.Issues
+		Fixed   string
+		Unfixed bool
+	}{
+		{
+			Name:    "defaults",
+			Content: string(content),
+			Want:    pascal,
+			Unfixed: true,
+		},
+		{
+			Name: "pascal",
+			Config: `
+rule "eos_tag_keys" {
+  enabled    = true
+  attributes = ["default_tags", "tags"]
+}`,
+			Content: string(content),
+			Want:    pascal,
+			Fixed:   string(fixed),
+		},
+		{
+			Name: "snake",
+			Config: `
+rule "eos_tag_keys" {
+  enabled    = true
+  style      = "snake"
+  attributes = ["tags", "metadata"]
+}`,
+			Content: string(content),
+			Want: helper.Issues{
+				{
+					Rule:    NewTagKeysRule(),
+					Message: makeTagKeyMessage("Name", "snake", "name"),
+					Range: hcl.Range{
+						Filename: "tag_keys_test.tf",
+						Start:    hcl.Pos{Line: 15, Column: 5},
+						End:      hcl.Pos{Line: 15, Column: 9},
+					},
+				},
+				{
+					Rule:    NewTagKeysRule(),
+					Message: makeTagKeyMessage("managed-by", "snake", "managed_by"),
+					Range: hcl.Range{
+						Filename: "tag_keys_test.tf",
+						Start:    hcl.Pos{Line: 22, Column: 5},
+						End:      hcl.Pos{Line: 22, Column: 15},
+					},
+				},
+				{
+					Rule:    NewTagKeysRule(),
+					Message: makeTagKeyMessage("ManagedBy", "snake", "managed_by"),
+					Range: hcl.Range{
+						Filename: "tag_keys_test.tf",
+						Start:    hcl.Pos{Line: 23, Column: 5},
+						End:      hcl.Pos{Line: 23, Column: 14},
+					},
+				},
+				{
+					Rule:    NewTagKeysRule(),
+					Message: makeTagKeyMessage("Name", "snake", "name"),
+					Range: hcl.Range{
+						Filename: "tag_keys_test.tf",
+						Start:    hcl.Pos{Line: 33, Column: 5},
+						End:      hcl.Pos{Line: 33, Column: 9},
+					},
+				},
+			},
+		},
+		{
+			Name:    "labels_default",
+			Content: string(labels),
+			Want:    helper.Issues{},
+		},
+		{
+			Name: "labels_snake",
+			Config: `
+rule "eos_tag_keys" {
+  enabled = true
+  styles  = { labels = "snake" }
+}`,
+			Content: string(labels),
+			Want: helper.Issues{
+				{
+					Rule:    NewTagKeysRule(),
+					Message: makeTagKeyMessage("CostCenter", "snake", "cost_center"),
+					Range: hcl.Range{
+						Filename: "tag_keys_test.tf",
+						Start:    hcl.Pos{Line: 6, Column: 5},
+						End:      hcl.Pos{Line: 6, Column: 15},
+					},
+				},
+			},
+			Fixed: string(labelsFixed),
+		},
+	}
+
+	for _, tc := range cases {
+
+		// Run the tests and make sure the basic results are found...
+		files := map[string]string{"tag_keys_test.tf": tc.Content}
+		if tc.Config != "" {
+			files[".tflint.hcl"] = tc.Config
+		}
+		runner := helper.TestRunner(t, files)
+		rule := NewTagKeysRule()
+
+		// ... no errors.
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		// ... and the expected number of issues.
+		if len(runner.Issues) != len(tc.Want) {
+			t.Logf("Expected %d issues, got %d", len(tc.Want), len(runner.Issues))
+			for i, issue := range runner.Issues {
+				t.Logf("Issue %d: %s at %s", i, issue.Message, issue.Range)
+			}
+			t.Fatalf("Number of issues mismatch: got %d, want %d", len(runner.Issues), len(tc.Want))
+		}
+
+		t.Run(tc.Name, func(t *testing.T) {
+			if *tagKeysDeep {
+				helper.AssertIssues(t, tc.Want, runner.Issues)
+			} else {
+				helper.AssertIssuesWithoutRange(t, tc.Want, runner.Issues)
+			}
+
+			// ... and the expected fixes.
+			if tc.Fixed != "" {
+				helper.AssertChanges(t, map[string]string{"tag_keys_test.tf": tc.Fixed}, runner.Changes())
+			}
+			if tc.Unfixed {
+				helper.AssertChanges(t, map[string]string{}, runner.Changes())
+			}
+		})
+	}
+}
+
+func makeTagKeyMessage(key string, style string, suggestion string) string {
+	return fmt.Sprintf("'%s' does not follow the %s key style. Consider '%s'.", key, style, suggestion)
+}
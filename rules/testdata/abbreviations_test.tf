@@ -0,0 +1,25 @@
+# #########
+# Tests that will emit issues.
+
+variable "svc_cfg" {}
+
+locals {
+  mgmt_port = 8080
+}
+
+resource "aws_s3_bucket" "log_bkt" {
+  bucket = "logs"
+}
+
+# #########
+# Tests that will not emit issues.
+
+variable "env" {}
+
+locals {
+  database_url = "postgres://localhost"
+}
+
+output "instance_id" {
+  value = local.mgmt_port
+}
@@ -0,0 +1,36 @@
+# #########
+# Tests that will emit issues.
+
+terraform {
+  required_version = ">= 1.6"
+}
+
+variable "zone" {}
+
+variable "region" {}
+
+resource "aws_s3_bucket" "logs" {
+  bucket = "logs"
+}
+
+provider "aws" {
+  region = var.region
+}
+
+output "bucket_name" {
+  value = aws_s3_bucket.logs.bucket
+}
+
+output "bucket_arn" {
+  value = aws_s3_bucket.logs.arn
+}
+
+# #########
+# Tests that will not emit issues.
+
+# Out of order, but only the first out-of-order block in a file is reported.
+data "aws_caller_identity" "current" {}
+
+module "network" {
+  source = "./network"
+}
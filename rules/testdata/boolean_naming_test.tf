@@ -0,0 +1,40 @@
+# #########
+# Tests that will emit issues.
+
+variable "monitoring" {
+  type = bool
+}
+
+variable "disable_encryption" {
+  type    = bool
+  default = false
+}
+
+variable "no_public_ip" {
+  type = bool
+}
+
+# #########
+# Tests that will not emit issues.
+
+variable "enable_logging" {
+  type = bool
+}
+
+variable "is_public" {
+  type = bool
+}
+
+variable "versioning_enabled" {
+  type = bool
+}
+
+variable "retention" {
+  type = number
+}
+
+variable "skip_list" {
+  type = list(string)
+}
+
+variable "untyped" {}
@@ -0,0 +1,30 @@
+# #########
+# Tests that will emit issues.
+
+variable "bucketName" {}
+
+locals {
+  log_prefix = "logs"
+}
+
+resource "aws_s3_bucket" "log_bucket" {
+  bucket = "${local.log_prefix}-${var.bucketName}"
+}
+
+moved {
+  from = aws_s3_bucket.Log_Bucket
+  to   = aws_s3_bucket.log_bucket
+}
+
+output "bucket_arn" {
+  value = aws_s3_bucket.log_bucket.arn
+}
+
+# #########
+# Tests that will not emit issues.
+
+variable "region" {}
+
+output "bucket-id" {
+  value = aws_s3_bucket.log_bucket.id
+}
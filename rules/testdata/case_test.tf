@@ -0,0 +1,25 @@
+# #########
+# Tests that will emit issues.
+
+variable "bucketName" {}
+
+locals {
+  logPrefix = "logs"
+}
+
+resource "aws_s3_bucket" "Log_Bucket" {
+  bucket = "${local.logPrefix}-${var.bucketName}"
+}
+
+output "bucket_arn" {
+  value = aws_s3_bucket.Log_Bucket.arn
+}
+
+# #########
+# Tests that will not emit issues.
+
+variable "region" {}
+
+output "bucket-id" {
+  value = aws_s3_bucket.Log_Bucket.id
+}
@@ -0,0 +1,47 @@
+# #########
+# Tests that will emit issues.
+
+variable "vpc_id" {
+  type        = string
+  description = "The vpc id"
+}
+
+variable "tags" {
+  type        = map(string)
+  description = "Tag values to use."
+}
+
+output "bucket_name" {
+  value       = aws_s3_bucket.logs.bucket
+  description = <<-EOT
+    The name of the bucket.
+  EOT
+}
+
+# #########
+# Tests that will not emit issues.
+
+variable "subnet_ids" {
+  type        = list(string)
+  description = "The private subnets to launch instances into."
+}
+
+variable "region" {
+  type        = string
+  description = ""
+}
+
+output "bucket_arn" {
+  value       = aws_s3_bucket.logs.arn
+  description = "The ARN of the log bucket, for IAM policies."
+}
+
+variable "vpc_cidr" {
+  type        = string
+  description = "VPC CIDR."
+}
+
+variable "retention_days" {
+  type        = number
+  description = "The value of this."
+}
@@ -0,0 +1,42 @@
+# #########
+# Tests that will emit issues.
+
+variable "region" {
+  type        = string
+  description = "the AWS region to deploy into."
+}
+
+variable "zone" {
+  type        = string
+  description = "The variable holds the availability zone"
+}
+
+variable "instance_type" {
+  type        = string
+  description = "Instance type. Do NOT use burstable types. "
+}
+
+output "bucket_arn" {
+  value       = aws_s3_bucket.logs.arn
+  description = <<-EOT
+    The ARN of the log bucket. 
+    IMPORTANT: Grant access through IAM, not bucket policies
+  EOT
+}
+
+# #########
+# Tests that will not emit issues.
+
+variable "tags" {
+  type        = map(string)
+  description = "Tags for every resource, such as `COST_CENTER` (see the wiki.)"
+}
+
+output "bucket_name" {
+  value       = aws_s3_bucket.logs.bucket
+  description = <<-EOT
+    The name of the log bucket.
+
+    It is used by the EC2 instances and the ARNs of their IAM roles.
+  EOT
+}
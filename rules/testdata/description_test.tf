@@ -0,0 +1,45 @@
+# #########
+# Tests that will emit issues.
+
+variable "region" {
+  type = string
+}
+
+variable "zone" {
+  type        = string
+  description = "  "
+}
+
+variable "instance_type" {
+  type        = string
+  description = "TBD"
+}
+
+variable "vpc_id" {
+  type        = string
+  description = "VPC ID."
+}
+
+output "bucket_arn" {
+  value = aws_s3_bucket.logs.arn
+}
+
+output "bucket_name" {
+  value       = aws_s3_bucket.logs.bucket
+  description = "todo."
+}
+
+# #########
+# Tests that will not emit issues.
+
+variable "tags" {
+  type        = map(string)
+  description = "Tags applied to every resource."
+}
+
+output "bucket_domain" {
+  value       = aws_s3_bucket.logs.bucket_domain_name
+  description = <<-EOT
+    The domain name of the log bucket, for building URLs.
+  EOT
+}
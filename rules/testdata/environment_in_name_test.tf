@@ -0,0 +1,39 @@
+# #########
+# Tests that will emit issues.
+
+resource "aws_db_instance" "prod_db" {
+  identifier = "db"
+}
+
+resource "aws_s3_bucket" "bucket_staging" {
+  bucket = "logs"
+}
+
+locals {
+  dev_cidr = "10.0.0.0/16"
+}
+
+module "uat_network" {
+  source = "./modules/network"
+}
+
+resource "aws_iam_role" "deployer" {
+  name = "deployer-prod"
+  tags = {
+    Name = "${var.team}-production-deployer"
+  }
+}
+
+# #########
+# Tests that will not emit issues.
+
+resource "aws_s3_bucket" "product" {
+  bucket = "product"
+}
+
+resource "aws_iam_role" "reader" {
+  name = "${var.environment}-reader"
+  tags = {
+    Owner = "prod-team"
+  }
+}
@@ -0,0 +1,27 @@
+# #########
+# Tests that will emit issues.
+
+terraform {
+  required_version = ">= 1.6"
+}
+
+provider "aws" {
+  region = "us-east-1"
+}
+
+variable "region" {}
+
+output "bucket_arn" {
+  value = aws_s3_bucket.logs.arn
+}
+
+# #########
+# Tests that will not emit issues.
+
+resource "aws_s3_bucket" "logs" {
+  bucket = "logs"
+}
+
+locals {
+  bucket = "logs"
+}
@@ -0,0 +1,37 @@
+# #########
+# Tests that will emit issues.
+
+variable "foo" {}
+
+locals {
+  temp = 1
+}
+
+resource "aws_subnet" "this" {
+  cidr_block = "10.0.1.0/24"
+}
+
+resource "aws_subnet" "private" {
+  cidr_block = "10.0.2.0/24"
+}
+
+module "example" {
+  source = "./modules/"
+}
+
+# #########
+# Tests that will not emit issues.
+
+resource "aws_vpc" "this" {
+  cidr_block = "10.0.0.0/16"
+}
+
+data "aws_region" "main" {}
+
+data "aws_vpc" "main" {
+  default = true
+}
+
+output "subnet_id" {
+  value = aws_subnet.this.id
+}
@@ -0,0 +1,41 @@
+# #########
+# Tests that will emit issues.
+
+variable "a" {}
+
+variable "ab" {}
+
+locals {
+  r1    = 1
+  twice = [for x in [1, 2] : x * 2]
+}
+
+resource "aws_instance" "web" {
+  dynamic "ebs_block_device" {
+    for_each = local.twice
+    iterator = d
+
+    content {
+      volume_size = d.value
+    }
+  }
+}
+
+# #########
+# Tests that will not emit issues.
+
+variable "id" {}
+
+resource "aws_s3_bucket" "s3" {
+  bucket = "logs"
+}
+
+resource "aws_instance" "ec2" {
+  ami = "ami-12345678"
+}
+
+variable "k8s" {}
+
+locals {
+  pairs = { for k, v in { one = 1 } : k => v }
+}
@@ -0,0 +1,54 @@
+# #########
+# Tests that will emit issues.
+
+resource "aws_instance" "web" {
+  ami           = "ami-12345678"
+  instance_type = "t3.micro"
+  # One per zone.
+  count = 2
+
+  depends_on = [aws_s3_bucket.logs]
+
+  tags = {
+    Name = "web"
+  }
+}
+
+resource "aws_s3_bucket" "logs" {
+  for_each = toset(["a", "b"])
+  bucket   = each.key
+  lifecycle {
+    prevent_destroy = true
+  }
+}
+
+module "network" {
+  source = "./network"
+  count  = 1
+
+  cidr = "10.0.0.0/16"
+  depends_on = [aws_s3_bucket.logs]
+}
+
+# #########
+# Tests that will not emit issues.
+
+resource "aws_eip" "web" {
+  count = 2
+
+  domain = "vpc"
+
+  lifecycle {
+    create_before_destroy = true
+  }
+
+  depends_on = [aws_instance.web]
+}
+
+data "aws_ami" "ubuntu" {
+  provider = aws.east
+
+  most_recent = true
+}
+
+resource "aws_s3_bucket" "assets" { for_each = toset([]) }
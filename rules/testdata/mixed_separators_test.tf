@@ -0,0 +1,25 @@
+# #########
+# Tests that will emit issues.
+
+variable "app-log_bucket" {}
+
+locals {
+  log-prefix = "logs"
+}
+
+resource "aws_s3_bucket" "access-logs" {
+  bucket = local.log-prefix
+}
+
+# #########
+# Tests that will not emit issues.
+
+variable "log_retention" {}
+
+resource "aws_s3_bucket" "audit_logs" {
+  bucket = "audit"
+}
+
+output "bucket_arn" {
+  value = aws_s3_bucket.audit_logs.arn
+}
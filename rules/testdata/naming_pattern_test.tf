@@ -0,0 +1,29 @@
+# #########
+# Tests that will emit issues.
+
+resource "aws_iam_role" "Deployer" {
+  name = "deployer"
+}
+
+variable "region" {}
+
+locals {
+  bucket = "logs"
+}
+
+# #########
+# Tests that will not emit issues.
+
+resource "aws_iam_policy" "read_only" {
+  policy = "{}"
+}
+
+resource "aws_s3_bucket" "Logs" {
+  bucket = "logs"
+}
+
+variable "in_region" {}
+
+locals {
+  local_prefix = "logs"
+}
@@ -0,0 +1,40 @@
+# #########
+# Tests that will emit issues.
+
+resource "aws_subnet" "subnet1" {
+  cidr_block = "10.0.1.0/24"
+}
+
+resource "aws_subnet" "subnet2" {
+  cidr_block = "10.0.2.0/24"
+}
+
+resource "aws_subnet" "subnet_3" {
+  cidr_block = "10.0.3.0/24"
+}
+
+locals {
+  zone-1 = "us-east-1a"
+  zone-2 = "us-east-1b"
+}
+
+# #########
+# Tests that will not emit issues.
+
+resource "aws_instance" "subnet4" {
+  ami = "ami-12345678"
+}
+
+data "aws_instance" "subnet5" {
+  instance_id = "i-12345678"
+}
+
+variable "zone3" {}
+
+resource "aws_s3_bucket" "logs" {
+  bucket = "logs"
+}
+
+resource "aws_s3_bucket" "logs_v2" {
+  bucket = "logs-v2"
+}
@@ -0,0 +1,29 @@
+# #########
+# Tests that will emit issues.
+
+variable "count" {}
+
+locals {
+  each = "value"
+}
+
+output "length" {
+  value = 1
+}
+
+module "source" {
+  source = "./modules/source"
+}
+
+# #########
+# Tests that will not emit issues.
+
+variable "instance_count" {}
+
+resource "aws_s3_bucket" "logs" {
+  bucket = "logs"
+}
+
+locals {
+  lookup_table = {}
+}
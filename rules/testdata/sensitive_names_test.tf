@@ -0,0 +1,45 @@
+# #########
+# Tests that will emit issues.
+
+variable "db_password" {
+  type = string
+}
+
+variable "github_api_key" {
+  type      = string
+  sensitive = false
+}
+
+output "accessToken" {
+  value = github_token.deploy.token
+}
+
+variable "connection_string" {
+  type      = string
+  sensitive = true
+}
+
+output "database_url" {
+  value = "postgres://${var.connection_string}"
+}
+
+# #########
+# Tests that will not emit issues.
+
+variable "admin_password" {
+  type      = string
+  sensitive = true
+}
+
+output "admin_password" {
+  value     = var.admin_password
+  sensitive = true
+}
+
+variable "key_name" {
+  type = string
+}
+
+output "secretary" {
+  value = var.key_name
+}
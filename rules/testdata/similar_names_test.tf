@@ -0,0 +1,49 @@
+# #########
+# Tests that will emit issues.
+
+variable "subnet_id" {}
+
+variable "subnets_id" {}
+
+locals {
+  bucket_name = "logs"
+  bucket-name = "archive"
+}
+
+# #########
+# Tests that will emit issues only with a threshold of 4.
+
+resource "aws_s3_bucket" "logs" {
+  bucket = "logs"
+}
+
+resource "aws_s3_bucket" "log" {
+  bucket = "log"
+}
+
+# #########
+# Tests that will not emit issues.
+
+output "subnet_ids" {
+  value = [var.subnet_id]
+}
+
+data "aws_s3_bucket" "logz" {
+  bucket = "logs"
+}
+
+resource "aws_subnet" "subnet1" {
+  cidr_block = "10.0.1.0/24"
+}
+
+resource "aws_subnet" "subnet2" {
+  cidr_block = "10.0.2.0/24"
+}
+
+variable "az" {}
+
+variable "ax" {}
+
+variable "primary" {}
+
+variable "replica" {}
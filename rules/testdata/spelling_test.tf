@@ -0,0 +1,42 @@
+# #########
+# Tests that will emit issues.
+
+# Storage for the aplication logs.
+resource "aws_s3_bucket" "log_bukcet" {
+  bucket = "logs"
+}
+
+variable "retention_days" {
+  description = "Number of days to retian the logs."
+  type        = number
+}
+
+locals {
+  replicaton_factor = 3
+}
+
+variable "logz_token" {
+  type = string
+}
+
+# #########
+# Tests that will not emit issues.
+
+# tflint-ignore: eos_recieve
+resource "aws_s3_bucket" "archive" {
+  bucket = "archive"
+}
+
+variable "kafka_brokers" {
+  description = "Brokers that don't need a VPC, see https://exampel.com/docs."
+  type        = list(string)
+}
+
+locals {
+  running_jobs = 2
+}
+
+output "bucket_arn" {
+  description = "The ARN of the archived bucket."
+  value       = aws_s3_bucket.archive.arn
+}
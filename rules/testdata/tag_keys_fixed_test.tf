@@ -0,0 +1,40 @@
+# #########
+# Tests that will emit issues.
+
+provider "aws" {
+  default_tags {
+    tags = {
+      CostCenter = "1234"
+    }
+  }
+}
+
+resource "aws_s3_bucket" "logs" {
+  bucket = "logs"
+  tags = merge(local.tags, {
+    Name    = "logs"
+    "Owner" = "platform"
+  })
+}
+
+locals {
+  tags = {
+    managed-by = "terraform"
+    ManagedBy  = "terraform"
+  }
+}
+
+# #########
+# Tests that will not emit issues.
+
+resource "aws_instance" "web" {
+  ami = "ami-12345678"
+  tags = {
+    Name                         = "web"
+    "kubernetes.io/cluster/main" = "owned"
+    (local.key)                  = "computed"
+  }
+  metadata = {
+    cost_center = "1234"
+  }
+}
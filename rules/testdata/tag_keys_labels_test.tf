@@ -0,0 +1,29 @@
+# #########
+# Tests that will emit issues when labels are checked.
+
+resource "google_storage_bucket" "logs" {
+  labels = {
+    CostCenter = "1234"
+    owner      = "platform"
+  }
+}
+
+# #########
+# Tests that will not emit issues.
+
+resource "kubernetes_deployment" "web" {
+  metadata {
+    labels = {
+      app  = "web"
+      tier = "frontend"
+    }
+  }
+
+  spec {
+    selector {
+      match_labels = {
+        app = "web"
+      }
+    }
+  }
+}
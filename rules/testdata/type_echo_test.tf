@@ -36,11 +36,28 @@ resource "aws_instance" "instance_echo" {
   ami = "ami-12345678"
 }
 
+module "vpc_network" {
+  source  = "terraform-aws-modules/vpc/aws"
+  version = "6.0.0"
+}
+
+module "subnets_public" {
+  source = "git::https://example.com/terraform-aws-subnets.git?ref=v1.0.0"
+}
+
+module "dns_zone" {
+  source = "../zone"
+}
+
 # #########
 # Tests that will not emit issues.
 
 variable "clean_var" {}
 
+module "network" {
+  source = "terraform-aws-modules/security-group/aws"
+}
+
 locals {
   clean_val = 1
 }
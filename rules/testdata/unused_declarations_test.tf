@@ -0,0 +1,52 @@
+# #########
+# Tests that will emit issues.
+
+variable "region" {
+  type = string
+}
+
+variable "zone" {
+  type = string
+
+  validation {
+    condition     = length(var.zone) > 0
+    error_message = "The zone must not be empty."
+  }
+}
+
+data "aws_ami" "unused" {
+  most_recent = true
+}
+
+locals {
+  prefix = "app"
+}
+
+# #########
+# Tests that will not emit issues.
+
+variable "instance_type" {
+  type = string
+}
+
+variable "tags" {
+  type = map(string)
+}
+
+data "aws_ami" "main" {
+  most_recent = true
+}
+
+locals {
+  name = "${var.instance_type}-server"
+  common_tags = merge(var.tags, {
+    Name = local.name
+  })
+}
+
+resource "aws_instance" "main" {
+  count         = 2
+  ami           = data.aws_ami.main.id
+  instance_type = var.instance_type
+  tags          = local.common_tags
+}
@@ -0,0 +1,43 @@
+# #########
+# Tests that will emit issues.
+
+variable "region" {
+  # Only the regions we have quotas in.
+  type        = string
+  description = "The AWS region to deploy into."
+  default     = "us-east-1"
+}
+
+variable "retention_days" {
+  type        = number # Whole days.
+  description = "How long logs are kept."
+  default     = 30
+
+  validation {
+    condition     = var.retention_days > 0
+    error_message = "Retention must be positive."
+  }
+}
+
+output "bucket_arn" {
+  description = "The ARN of the log bucket."
+  value       = aws_s3_bucket.logs.arn
+}
+
+# #########
+# Tests that will not emit issues.
+
+variable "tags" {
+  type        = map(string)
+  description = "Tags for every resource."
+  default     = {}
+  nullable    = false
+}
+
+output "bucket_name" {
+  description = "The name of the log bucket."
+  value       = aws_s3_bucket.logs.bucket
+  sensitive   = false
+
+  depends_on = [aws_s3_bucket.logs]
+}
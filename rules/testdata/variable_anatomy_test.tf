@@ -0,0 +1,44 @@
+# #########
+# Tests that will emit issues.
+
+variable "region" {
+  description = "The AWS region to deploy into."
+  # Only the regions we have quotas in.
+  type    = string
+  default = "us-east-1"
+}
+
+variable "retention_days" {
+  default = 30
+
+  validation {
+    condition     = var.retention_days > 0
+    error_message = "Retention must be positive."
+  }
+
+  type        = number # Whole days.
+  description = "How long logs are kept."
+}
+
+output "bucket_arn" {
+  value       = aws_s3_bucket.logs.arn
+  description = "The ARN of the log bucket."
+}
+
+# #########
+# Tests that will not emit issues.
+
+variable "tags" {
+  type        = map(string)
+  description = "Tags for every resource."
+  default     = {}
+  nullable    = false
+}
+
+output "bucket_name" {
+  description = "The name of the log bucket."
+  value       = aws_s3_bucket.logs.bucket
+  sensitive   = false
+
+  depends_on = [aws_s3_bucket.logs]
+}
@@ -0,0 +1,77 @@
+# #########
+# Tests that will emit issues.
+
+variable "region" {
+  description = "The AWS region to deploy into."
+}
+
+variable "settings" {
+  type = any
+}
+
+variable "tags" {
+  type = map
+}
+
+variable "zones" {
+  type = list(string)
+}
+
+variable "owner" {
+  type = string
+}
+
+variable "servers" {
+  type = list(object({
+    name     = string
+    size     = string
+    image    = string
+    zone     = string
+    subnet   = string
+    key_name = string
+  }))
+}
+
+variable "labels" {
+  type = map(any)
+}
+
+variable "rules" {
+  type = list(any)
+}
+
+variable "network" {
+  type = object({
+    cidr  = string
+    extra = any
+  })
+}
+
+variable "listener" {
+  type = tuple([number, any])
+}
+
+# #########
+# Tests that will not emit issues.
+
+variable "subnet_ids" {
+  type = list(string)
+}
+
+variable "instance" {
+  type = object({
+    name     = string
+    size     = optional(string, "small")
+    image    = string
+    zone     = string
+    subnet   = string
+    key_name = string
+  })
+}
+
+variable "ports" {
+  type = map(object({
+    from = number
+    to   = optional(number, 0)
+  }))
+}
@@ -5,6 +5,8 @@ package rules
 
 import (
 	"fmt"
+	"regexp"
+	"slices"
 	"strings"
 
 	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
@@ -22,10 +24,19 @@ var defaultTypeEchoConfig = typeEchoRuleConfig{
 	Level: "warning",
 }
 
+// moduleRepoPrefix matches the "terraform-<provider>-" prefix that the
+// registry requires of module repository names.
+var moduleRepoPrefix = regexp.MustCompile(`^terraform-[a-z0-9]+-`)
+
 // TypeEchoRule checks whether a block's type is echoed in its name.
 type TypeEchoRule struct {
 	tflint.DefaultRule
 	Config typeEchoRuleConfig
+
+	// names indexes the module's names for suggestions.
+	names nameIndex
+	// sources maps module call names to their source address.
+	sources map[string]string
 }
 
 // Check checks whether the rule conditions are met.
@@ -34,6 +45,38 @@ func (r *TypeEchoRule) Check(runner tflint.Runner) error {
 		return err
 	}
 
+	// Only the source is needed, so a bad version elsewhere in the module
+	// call does not stop the rule.
+	content, err := runner.GetModuleContent(&hclext.BodySchema{
+		Blocks: buildBlockSchemas([]BlockDef{
+			{Typ: "module", Labels: []string{"name"}, Attributes: []string{"source"}},
+		}),
+	}, &tflint.GetModuleContentOption{ExpandMode: tflint.ExpandModeNone})
+	if err != nil {
+		return err
+	}
+
+	r.sources = map[string]string{}
+	for _, block := range content.Blocks {
+		attr, ok := block.Body.Attributes["source"]
+		if !ok {
+			continue
+		}
+		err := runner.EvaluateExpr(attr.Expr, func(source string) error {
+			r.sources[block.Labels[0]] = source
+			return nil
+		}, nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	names, err := indexNames(runner, allLintableBlocks)
+	if err != nil {
+		return err
+	}
+	r.names = names
+
 	return CheckBlocksAndLocals(runner, allLintableBlocks, r, checkForEcho)
 }
 
@@ -83,14 +126,147 @@ func checkForEcho(runner tflint.Runner,
 
 	if echo {
 		// logger.Debug(fmt.Sprintf("emiting issue for type='%s' name='%s'", typ, name))
-		if err := runner.EmitIssue(
-			r,
+		message := withSuggestion(
 			fmt.Sprintf("The type \"%s\" is echoed%s in the label \"%s\".", typ, synonymText, name),
-			block.DefRange,
-		); err != nil {
+			suggestName(name, nameKind(block, typ), r.names, stripEcho(lowerTyp, r.Config.Synonyms, synonym)),
+		)
+		if err := runner.EmitIssue(r, message, block.DefRange); err != nil {
+			logger.Error(err.Error())
+		}
+		return
+	}
+
+	// A module call has no type label, so its source stands in for one.
+	if typ == "module" {
+		checkForSourceEcho(runner, r, block, name)
+	}
+}
+
+// checkForSourceEcho checks if the words naming a module's source are echoed
+// in the name of the module call.
+func checkForSourceEcho(runner tflint.Runner, r *TypeEchoRule, block *hclext.Block, name string) {
+	source := r.sources[name]
+	if source == "" {
+		return
+	}
+
+	sourceWords := moduleSourceWords(source)
+	for _, word := range tokenizeName(name) {
+		if !slices.Contains(sourceWords, word) {
+			continue
+		}
+
+		message := withSuggestion(
+			fmt.Sprintf("The module source \"%s\" is echoed in the label \"%s\".", source, name),
+			suggestName(name, nameKind(block, "module"), r.names, func(w string) string {
+				if slices.Contains(sourceWords, w) {
+					return ""
+				}
+				return w
+			}),
+		)
+		if err := runner.EmitIssue(r, message, block.DefRange); err != nil {
 			logger.Error(err.Error())
 		}
+		return
+	}
+}
+
+// stripEcho returns a rewrite func for suggestName that drops words echoing
+// any part of the type or one of its synonyms.
+func stripEcho(lowerTyp string, synonyms map[string][]string, synonym string) func(string) string {
+	var echoes []string
+	for part := range strings.SplitSeq(lowerTyp, "_") {
+		if part != "" {
+			echoes = append(echoes, part)
+		}
+		echoes = append(echoes, synonyms[part]...)
+	}
+	if synonym != "" {
+		echoes = append(echoes, synonym)
+	}
+
+	return func(word string) string {
+		for _, echo := range echoes {
+			if strings.Contains(word, echo) {
+				return ""
+			}
+		}
+		return word
+	}
+}
+
+// moduleSourceWords derives the words that name a module from its source
+// address. For example -
+//
+//	terraform-aws-modules/vpc/aws                      vpc
+//	git::https://example.com/terraform-aws-subnets.git subnets
+//	github.com/example/network//modules/nat-gateway    nat, gateway
+//	../zone                                            zone
+func moduleSourceWords(source string) []string {
+	src := source
+
+	// Drop the forced getter ("git::") and any query string ("?ref=v1.0.0").
+	if i := strings.Index(src, "::"); i >= 0 {
+		src = src[i+2:]
+	}
+	if i := strings.IndexAny(src, "?#"); i >= 0 {
+		src = src[:i]
+	}
+
+	scheme := ""
+	if i := strings.Index(src, "://"); i >= 0 {
+		scheme = src[:i]
+		src = src[i+3:]
+	}
+
+	// A "//" subdirectory names the module more precisely than its package.
+	if i := strings.Index(src, "//"); i >= 0 {
+		return tokenizeName(lastSegment(src[i+2:]))
+	}
+
+	if strings.HasPrefix(src, "./") || strings.HasPrefix(src, "../") {
+		return tokenizeName(lastSegment(src))
+	}
+
+	// Registry addresses are <namespace>/<name>/<provider>, optionally with a
+	// leading hostname. The name is the second to last segment.
+	segments := strings.Split(strings.Trim(src, "/"), "/")
+	registry := scheme == "" && !strings.Contains(src, ":") &&
+		((len(segments) == 3 && !strings.Contains(segments[0], ".")) ||
+			(len(segments) == 4 && strings.Contains(segments[0], ".") && !isVCSHost(segments[0])))
+	if registry {
+		return tokenizeName(segments[len(segments)-2])
+	}
+
+	// Everything else is a repository or archive URL.
+	last := lastSegment(src)
+	for _, ext := range []string{".git", ".zip", ".tar.gz", ".tgz"} {
+		last = strings.TrimSuffix(last, ext)
+	}
+	return tokenizeName(moduleRepoPrefix.ReplaceAllString(last, ""))
+}
+
+// lastSegment returns the last non-empty segment of a path, treating the ':'
+// in scp-like git addresses as a separator.
+func lastSegment(path string) string {
+	segments := strings.FieldsFunc(path, func(r rune) bool {
+		return r == '/' || r == ':'
+	})
+	if len(segments) == 0 {
+		return ""
+	}
+	return segments[len(segments)-1]
+}
+
+// isVCSHost reports whether host is one of the VCS hosts that Terraform
+// recognizes without a forced getter.
+func isVCSHost(host string) bool {
+	switch host {
+	case "github.com", "bitbucket.org":
+		return true
 	}
+	return false
 }
 
 // NewTypeEchoRule returns a new rule.
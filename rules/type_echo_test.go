@@ -6,6 +6,7 @@ package rules
 import (
 	"flag"
 	"fmt"
+	"strings"
 	"testing"
 
 	"os"
@@ -33,7 +34,7 @@ func TestTypeEchoRule(t *testing.T) {
 			Want: helper.Issues{
 				{
 					Rule:    NewTypeEchoRule(),
-					Message: makeTypeEchoMessage("variable", "variable_echo"),
+					Message: makeTypeEchoMessage("variable", "variable_echo", "echo"),
 					Range: hcl.Range{
 						Filename: "type_echo_test.tf",
 						Start:    hcl.Pos{Line: 7, Column: 1},
@@ -42,7 +43,7 @@ func TestTypeEchoRule(t *testing.T) {
 				},
 				{
 					Rule:    NewTypeEchoRule(),
-					Message: makeTypeEchoMessage("check", "check_echo"),
+					Message: makeTypeEchoMessage("check", "check_echo", "echo"),
 					Range: hcl.Range{
 						Filename: "type_echo_test.tf",
 						Start:    hcl.Pos{Line: 13, Column: 1},
@@ -51,7 +52,7 @@ func TestTypeEchoRule(t *testing.T) {
 				},
 				{
 					Rule:    NewTypeEchoRule(),
-					Message: makeTypeEchoMessage("aws_caller_identity", "caller_echo"),
+					Message: makeTypeEchoMessage("aws_caller_identity", "caller_echo", "echo"),
 					Range: hcl.Range{
 						Filename: "type_echo_test.tf",
 						Start:    hcl.Pos{Line: 20, Column: 1},
@@ -60,7 +61,7 @@ func TestTypeEchoRule(t *testing.T) {
 				},
 				{
 					Rule:    NewTypeEchoRule(),
-					Message: makeTypeEchoMessage("random_password", "password_echo"),
+					Message: makeTypeEchoMessage("random_password", "password_echo", "echo"),
 					Range: hcl.Range{
 						Filename: "type_echo_test.tf",
 						Start:    hcl.Pos{Line: 22, Column: 1},
@@ -69,7 +70,7 @@ func TestTypeEchoRule(t *testing.T) {
 				},
 				{
 					Rule:    NewTypeEchoRule(),
-					Message: makeTypeEchoMessage("module", "module_echo"),
+					Message: makeTypeEchoMessage("module", "module_echo", "echo"),
 					Range: hcl.Range{
 						Filename: "type_echo_test.tf",
 						Start:    hcl.Pos{Line: 26, Column: 1},
@@ -78,7 +79,7 @@ func TestTypeEchoRule(t *testing.T) {
 				},
 				{
 					Rule:    NewTypeEchoRule(),
-					Message: makeTypeEchoMessage("output", "output_echo"),
+					Message: makeTypeEchoMessage("output", "output_echo", "echo"),
 					Range: hcl.Range{
 						Filename: "type_echo_test.tf",
 						Start:    hcl.Pos{Line: 30, Column: 1},
@@ -87,7 +88,7 @@ func TestTypeEchoRule(t *testing.T) {
 				},
 				{
 					Rule:    NewTypeEchoRule(),
-					Message: makeTypeEchoMessage("aws_instance", "instance_echo"),
+					Message: makeTypeEchoMessage("aws_instance", "instance_echo", "echo"),
 					Range: hcl.Range{
 						Filename: "type_echo_test.tf",
 						Start:    hcl.Pos{Line: 35, Column: 1},
@@ -96,13 +97,72 @@ func TestTypeEchoRule(t *testing.T) {
 				},
 				{
 					Rule:    NewTypeEchoRule(),
-					Message: makeTypeEchoMessage("local", "local_echo"),
+					Message: makeTypeEchoMessage("local", "local_echo", "echo"),
 					Range: hcl.Range{
 						Filename: "type_echo_test.tf",
 						Start:    hcl.Pos{Line: 10, Column: 3},
 						End:      hcl.Pos{Line: 10, Column: 17},
 					},
 				},
+				{
+					Rule:    NewTypeEchoRule(),
+					Message: makeSourceEchoMessage("terraform-aws-modules/vpc/aws", "vpc_network", "network_2"),
+					Range: hcl.Range{
+						Filename: "type_echo_test.tf",
+						Start:    hcl.Pos{Line: 39, Column: 1},
+						End:      hcl.Pos{Line: 39, Column: 21},
+					},
+				},
+				{
+					Rule:    NewTypeEchoRule(),
+					Message: makeSourceEchoMessage("git::https://example.com/terraform-aws-subnets.git?ref=v1.0.0", "subnets_public", "public"),
+					Range: hcl.Range{
+						Filename: "type_echo_test.tf",
+						Start:    hcl.Pos{Line: 44, Column: 1},
+						End:      hcl.Pos{Line: 44, Column: 24},
+					},
+				},
+				{
+					Rule:    NewTypeEchoRule(),
+					Message: makeSourceEchoMessage("../zone", "dns_zone", "dns"),
+					Range: hcl.Range{
+						Filename: "type_echo_test.tf",
+						Start:    hcl.Pos{Line: 48, Column: 1},
+						End:      hcl.Pos{Line: 48, Column: 18},
+					},
+				},
+			},
+		},
+		{
+			Name: "invalid_version",
+			Content: `
+module "vpc_network" {
+  source  = "terraform-aws-modules/vpc/aws"
+  version = "latest"
+}
+
+resource "aws_instance" "instance_echo" {
+  ami = "ami-12345678"
+}`,
+			Want: helper.Issues{
+				{
+					Rule:    NewTypeEchoRule(),
+					Message: makeSourceEchoMessage("terraform-aws-modules/vpc/aws", "vpc_network", "network"),
+					Range: hcl.Range{
+						Filename: "type_echo_test.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 21},
+					},
+				},
+				{
+					Rule:    NewTypeEchoRule(),
+					Message: makeTypeEchoMessage("aws_instance", "instance_echo", "echo"),
+					Range: hcl.Range{
+						Filename: "type_echo_test.tf",
+						Start:    hcl.Pos{Line: 7, Column: 1},
+						End:      hcl.Pos{Line: 7, Column: 40},
+					},
+				},
 			},
 		},
 	}
@@ -137,6 +197,36 @@ func TestTypeEchoRule(t *testing.T) {
 	}
 }
 
-func makeTypeEchoMessage(typ string, name string) string {
-	return fmt.Sprintf("The type \"%s\" is echoed in the label \"%s\".", typ, name)
+func TestModuleSourceWords(t *testing.T) {
+	cases := []struct {
+		Source string
+		Want   []string
+	}{
+		{Source: "terraform-aws-modules/vpc/aws", Want: []string{"vpc"}},
+		{Source: "app.terraform.io/example/security-group/aws", Want: []string{"security", "group"}},
+		{Source: "terraform-aws-modules/vpc/aws//modules/vpc-endpoints", Want: []string{"vpc", "endpoints"}},
+		{Source: "github.com/example/terraform-aws-subnets", Want: []string{"subnets"}},
+		{Source: "git::https://example.com/terraform-aws-subnets.git?ref=v1.0.0", Want: []string{"subnets"}},
+		{Source: "git@github.com:example/terraform-google-network.git", Want: []string{"network"}},
+		{Source: "s3::https://s3.amazonaws.com/example/nat-gateway.zip", Want: []string{"nat", "gateway"}},
+		{Source: "./modules/dns-zone", Want: []string{"dns", "zone"}},
+		{Source: "../zone/", Want: []string{"zone"}},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Source, func(t *testing.T) {
+			got := moduleSourceWords(tc.Source)
+			if strings.Join(got, ",") != strings.Join(tc.Want, ",") {
+				t.Errorf("moduleSourceWords(%q) = %v, want %v", tc.Source, got, tc.Want)
+			}
+		})
+	}
+}
+
+func makeSourceEchoMessage(source string, name string, suggestion string) string {
+	return fmt.Sprintf("The module source \"%s\" is echoed in the label \"%s\". Consider '%s'.", source, name, suggestion)
+}
+
+func makeTypeEchoMessage(typ string, name string, suggestion string) string {
+	return fmt.Sprintf("The type \"%s\" is echoed in the label \"%s\". Consider '%s'.", typ, name, suggestion)
 }
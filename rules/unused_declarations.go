@@ -0,0 +1,167 @@
+// Copyright (c) 2025 Steve Taranto <staranto@gmail.com>.
+// SPDX-License-Identifier: Apache-2.0
+
+package rules
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/staranto/tflint-ruleset-elements-of-style/terraform"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/logger"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+var defaultUnusedDeclarationsConfig = unusedDeclarationsRuleConfig{
+	Level:             "warning",
+	SkipRootVariables: false,
+}
+
+// unusedDeclarationsRuleConfig represents the configuration for the
+// UnusedDeclarationsRule.
+type unusedDeclarationsRuleConfig struct {
+	Level             string `hclext:"level,optional"`
+	SkipRootVariables bool   `hclext:"skip_root_variables,optional"`
+}
+
+// UnusedDeclarationsRule checks whether variables, locals and data sources
+// are referenced anywhere in the module.
+type UnusedDeclarationsRule struct {
+	tflint.DefaultRule
+	Config unusedDeclarationsRuleConfig
+}
+
+// Check checks whether the rule conditions are met.
+func (r *UnusedDeclarationsRule) Check(runner tflint.Runner) error {
+	if err := runner.DecodeRuleConfig(r.Name(), &r.Config); err != nil {
+		return err
+	}
+
+	path, err := runner.GetModulePath()
+	if err != nil {
+		return err
+	}
+	variables := !(r.Config.SkipRootVariables && path.IsRoot())
+
+	content, err := runner.GetModuleContent(&hclext.BodySchema{
+		Blocks: []hclext.BlockSchema{
+			{
+				Type:       "variable",
+				LabelNames: []string{"name"},
+				Body: &hclext.BodySchema{
+					Blocks: []hclext.BlockSchema{
+						{
+							Type: "validation",
+							Body: &hclext.BodySchema{
+								Attributes: []hclext.AttributeSchema{
+									{Name: "condition"},
+									{Name: "error_message"},
+								},
+							},
+						},
+					},
+				},
+			},
+			{
+				Type:       "data",
+				LabelNames: []string{"type", "name"},
+				Body:       &hclext.BodySchema{},
+			},
+		},
+	}, &tflint.GetModuleContentOption{ExpandMode: tflint.ExpandModeNone})
+	if err != nil {
+		return err
+	}
+
+	refs, diags := terraform.NewRunner(runner).GetReferences()
+	if diags.HasErrors() {
+		return diags
+	}
+
+	for _, block := range content.Blocks {
+		switch block.Type {
+		case "variable":
+			if !variables {
+				continue
+			}
+			// A variable's own validation does not count as a use of it.
+			var validations []hcl.Range
+			for _, validation := range block.Body.Blocks {
+				for _, attr := range validation.Body.Attributes {
+					validations = append(validations, attr.Range)
+				}
+			}
+			if !isReferenced(refs["var."+block.Labels[0]], validations) {
+				r.emit(runner, describeBlock(block), block.DefRange)
+			}
+		case "data":
+			if !isReferenced(refs["data."+block.Labels[0]+"."+block.Labels[1]], nil) {
+				r.emit(runner, describeBlock(block), block.DefRange)
+			}
+		}
+	}
+
+	locals, diags := getLocals(runner)
+	if diags != nil {
+		return diags
+	}
+	for _, name := range slices.Sorted(maps.Keys(locals)) {
+		if !isReferenced(refs["local."+name], nil) {
+			r.emit(runner, "local."+name, locals[name].DefRange)
+		}
+	}
+
+	return nil
+}
+
+// isReferenced reports whether a reference has a range outside of all the
+// excluded ranges.
+func isReferenced(ref *terraform.Reference, excluded []hcl.Range) bool {
+	if ref == nil {
+		return false
+	}
+	return slices.ContainsFunc(ref.Ranges, func(rng hcl.Range) bool {
+		return !slices.ContainsFunc(excluded, func(exclude hcl.Range) bool {
+			return exclude.Filename == rng.Filename && exclude.ContainsOffset(rng.Start.Byte)
+		})
+	})
+}
+
+// emit reports a declaration that is never used.
+func (r *UnusedDeclarationsRule) emit(runner tflint.Runner, declaration string, rng hcl.Range) {
+	message := fmt.Sprintf("%s is declared but never used.", declaration)
+	if err := runner.EmitIssue(r, message, rng); err != nil {
+		logger.Error(err.Error())
+	}
+	logger.Debug(message)
+}
+
+// NewUnusedDeclarationsRule returns a new rule.
+func NewUnusedDeclarationsRule() *UnusedDeclarationsRule {
+	rule := &UnusedDeclarationsRule{}
+	rule.Config = defaultUnusedDeclarationsConfig
+	return rule
+}
+
+// Enabled returns whether the rule is enabled by default.
+func (r *UnusedDeclarationsRule) Enabled() bool {
+	return true
+}
+
+// Link returns the rule reference link.
+func (r *UnusedDeclarationsRule) Link() string {
+	return "https://github.com/staranto/tflint-ruleset-elements-of-style/blob/main/docs/rules/eos_unused_declarations.md"
+}
+
+// Name returns the rule name.
+func (r *UnusedDeclarationsRule) Name() string {
+	return "eos_unused_declarations"
+}
+
+// Severity returns the rule severity.
+func (r *UnusedDeclarationsRule) Severity() tflint.Severity {
+	return toSeverity(r.Config.Level)
+}
@@ -0,0 +1,124 @@
+// Copyright (c) 2025 Steve Taranto <staranto@gmail.com>.
+// SPDX-License-Identifier: Apache-2.0
+
+package rules
+
+import (
+	"flag"
+	"fmt"
+	"testing"
+
+	"os"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+var unusedDeclarationsDeep = flag.Bool("unusedDeclarationsDeep", false, "enable deep assert")
+
+func TestUnusedDeclarationsRule(t *testing.T) {
+	flag.Parse()
+
+	content, _ := os.ReadFile("testdata/unused_declarations_test.tf")
+
+	variables := helper.Issues{
+		{
+			Rule:    NewUnusedDeclarationsRule(),
+			Message: makeUnusedDeclarationMessage(`variable "region"`),
+			Range: hcl.Range{
+				Filename: "unused_declarations_test.tf",
+				Start:    hcl.Pos{Line: 4, Column: 1},
+				End:      hcl.Pos{Line: 4, Column: 18},
+			},
+		},
+		{
+			Rule:    NewUnusedDeclarationsRule(),
+			Message: makeUnusedDeclarationMessage(`variable "zone"`),
+			Range: hcl.Range{
+				Filename: "unused_declarations_test.tf",
+				Start:    hcl.Pos{Line: 8, Column: 1},
+				End:      hcl.Pos{Line: 8, Column: 16},
+			},
+		},
+	}
+	others := helper.Issues{
+		{
+			Rule:    NewUnusedDeclarationsRule(),
+			Message: makeUnusedDeclarationMessage(`data "aws_ami" "unused"`),
+			Range: hcl.Range{
+				Filename: "unused_declarations_test.tf",
+				Start:    hcl.Pos{Line: 17, Column: 1},
+				End:      hcl.Pos{Line: 17, Column: 24},
+			},
+		},
+		{
+			Rule:    NewUnusedDeclarationsRule(),
+			Message: makeUnusedDeclarationMessage("local.prefix"),
+			Range: hcl.Range{
+				Filename: "unused_declarations_test.tf",
+				Start:    hcl.Pos{Line: 22, Column: 3},
+				End:      hcl.Pos{Line: 22, Column: 17},
+			},
+		},
+	}
+
+	cases := []struct {
+		Name    string
+		Config  string
+		Content string
+		Want    helper.Issues
+	}{
+		{
+			Name:    "unused_declarations",
+			Content: string(content),
+			Want:    append(append(helper.Issues{}, variables...), others...),
+		},
+		{
+			Name: "skip_root_variables",
+			Config: `
+rule "eos_unused_declarations" {
+  enabled             = true
+  skip_root_variables = true
+}`,
+			Content: string(content),
+			Want:    others,
+		},
+	}
+
+	for _, tc := range cases {
+
+		// Run the tests and make sure the basic results are found...
+		files := map[string]string{"unused_declarations_test.tf": tc.Content}
+		if tc.Config != "" {
+			files[".tflint.hcl"] = tc.Config
+		}
+		runner := helper.TestRunner(t, files)
+		rule := NewUnusedDeclarationsRule()
+
+		// ... no errors.
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		// ... and the expected number of issues.
+		if len(runner.Issues) != len(tc.Want) {
+			t.Logf("Expected %d issues, got %d", len(tc.Want), len(runner.Issues))
+			for i, issue := range runner.Issues {
+				t.Logf("Issue %d: %s at %s", i, issue.Message, issue.Range)
+			}
+			t.Fatalf("Number of issues mismatch: got %d, want %d", len(runner.Issues), len(tc.Want))
+		}
+
+		t.Run(tc.Name, func(t *testing.T) {
+			if *unusedDeclarationsDeep {
+				helper.AssertIssues(t, tc.Want, runner.Issues)
+			} else {
+				helper.AssertIssuesWithoutRange(t, tc.Want, runner.Issues)
+			}
+		})
+	}
+}
+
+func makeUnusedDeclarationMessage(declaration string) string {
+	return fmt.Sprintf("%s is declared but never used.", declaration)
+}
@@ -0,0 +1,137 @@
+// Copyright (c) 2025 Steve Taranto <staranto@gmail.com>.
+// SPDX-License-Identifier: Apache-2.0
+
+package rules
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/logger"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// anatomyBlocks are the blocks whose bodies have a canonical order.
+var anatomyBlocks = []BlockDef{
+	{Typ: "output", Labels: []string{"name"}},
+	{Typ: "variable", Labels: []string{"name"}},
+}
+
+var defaultVariableAnatomyConfig = variableAnatomyRuleConfig{
+	Level:    "warning",
+	Output:   []string{"description", "value", "sensitive", "ephemeral", "precondition", "depends_on"},
+	Variable: []string{"type", "description", "default", "sensitive", "nullable", "ephemeral", "validation"},
+}
+
+// variableAnatomyRuleConfig represents the configuration for the
+// VariableAnatomyRule.
+type variableAnatomyRuleConfig struct {
+	Level    string   `hclext:"level,optional"`
+	Output   []string `hclext:"output,optional"`
+	Variable []string `hclext:"variable,optional"`
+}
+
+// VariableAnatomyRule checks whether the arguments and blocks of variables
+// and outputs are in the canonical order.
+type VariableAnatomyRule struct {
+	tflint.DefaultRule
+	Config variableAnatomyRuleConfig
+}
+
+// Check checks whether the rule conditions are met.
+func (r *VariableAnatomyRule) Check(runner tflint.Runner) error {
+	if err := runner.DecodeRuleConfig(r.Name(), &r.Config); err != nil {
+		return err
+	}
+
+	content, err := runner.GetModuleContent(&hclext.BodySchema{
+		Blocks: buildBlockSchemas(anatomyBlocks),
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, block := range content.Blocks {
+		// JSON bodies have no order to check.
+		syntaxBlock := findSyntaxBlock(runner, block.DefRange)
+		if syntaxBlock == nil {
+			continue
+		}
+		file, err := runner.GetFile(block.DefRange.Filename)
+		if err != nil {
+			return err
+		}
+
+		order := r.Config.Variable
+		if block.Type == "output" {
+			order = r.Config.Output
+		}
+		r.checkBlock(runner, block, syntaxBlock, file.Bytes, order)
+	}
+
+	return nil
+}
+
+// checkBlock reports each item that follows an item ordered after it.
+func (r *VariableAnatomyRule) checkBlock(runner tflint.Runner, block *hclext.Block, syntaxBlock *hclsyntax.Block, src []byte, order []string) {
+	// Items that are not in the order go last.
+	rank := func(item bodyItem) int {
+		if i := slices.Index(order, item.name); i >= 0 {
+			return i
+		}
+		return len(order)
+	}
+
+	items := bodyItems(syntaxBlock.Body)
+	sorted := slices.Clone(items)
+	slices.SortStableFunc(sorted, func(a, b bodyItem) int {
+		return rank(a) - rank(b)
+	})
+	fix := func(f tflint.Fixer) error {
+		return reorderBody(f, src, syntaxBlock, [][]bodyItem{sorted})
+	}
+
+	for i, item := range items {
+		for _, previous := range items[:i] {
+			if rank(previous) <= rank(item) {
+				continue
+			}
+
+			message := fmt.Sprintf("'%s' should come before '%s' in %s.", item.name, previous.name, describeBlock(block))
+			if err := runner.EmitIssueWithFix(r, message, item.key, fix); err != nil {
+				logger.Error(err.Error())
+			}
+			logger.Debug(message)
+			break
+		}
+	}
+}
+
+// NewVariableAnatomyRule returns a new rule.
+func NewVariableAnatomyRule() *VariableAnatomyRule {
+	rule := &VariableAnatomyRule{}
+	rule.Config = defaultVariableAnatomyConfig
+	return rule
+}
+
+// Enabled returns whether the rule is enabled by default.
+func (r *VariableAnatomyRule) Enabled() bool {
+	return true
+}
+
+// Link returns the rule reference link.
+func (r *VariableAnatomyRule) Link() string {
+	return "https://github.com/staranto/tflint-ruleset-elements-of-style/blob/main/docs/rules/eos_variable_anatomy.md"
+}
+
+// Name returns the rule name.
+func (r *VariableAnatomyRule) Name() string {
+	return "eos_variable_anatomy"
+}
+
+// Severity returns the rule severity.
+func (r *VariableAnatomyRule) Severity() tflint.Severity {
+	return toSeverity(r.Config.Level)
+}
@@ -0,0 +1,166 @@
+// Copyright (c) 2025 Steve Taranto <staranto@gmail.com>.
+// SPDX-License-Identifier: Apache-2.0
+
+package rules
+
+import (
+	"flag"
+	"fmt"
+	"testing"
+
+	"os"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+var variableAnatomyDeep = flag.Bool("variableAnatomyDeep", false, "enable deep assert")
+
+func TestVariableAnatomyRule(t *testing.T) {
+	flag.Parse()
+
+	content, _ := os.ReadFile("testdata/variable_anatomy_test.tf")
+	fixed, _ := os.ReadFile("testdata/variable_anatomy_fixed_test.tf")
+
+	cases := []struct {
+		Name    string
+		Config  string
+		Content string
+		Want    helper.Issues
+		Fixed   string
+	}{
+		{
+			Name:    "variable_anatomy",
+			Content: string(content),
+			Want: helper.Issues{
+				{
+					Rule:    NewVariableAnatomyRule(),
+					Message: makeVariableAnatomyMessage("type", "description", `variable "region"`),
+					Range: hcl.Range{
+						Filename: "variable_anatomy_test.tf",
+						Start:    hcl.Pos{Line: 7, Column: 3},
+						End:      hcl.Pos{Line: 7, Column: 7},
+					},
+				},
+				{
+					Rule:    NewVariableAnatomyRule(),
+					Message: makeVariableAnatomyMessage("type", "default", `variable "retention_days"`),
+					Range: hcl.Range{
+						Filename: "variable_anatomy_test.tf",
+						Start:    hcl.Pos{Line: 19, Column: 3},
+						End:      hcl.Pos{Line: 19, Column: 7},
+					},
+				},
+				{
+					Rule:    NewVariableAnatomyRule(),
+					Message: makeVariableAnatomyMessage("description", "default", `variable "retention_days"`),
+					Range: hcl.Range{
+						Filename: "variable_anatomy_test.tf",
+						Start:    hcl.Pos{Line: 20, Column: 3},
+						End:      hcl.Pos{Line: 20, Column: 14},
+					},
+				},
+				{
+					Rule:    NewVariableAnatomyRule(),
+					Message: makeVariableAnatomyMessage("description", "value", `output "bucket_arn"`),
+					Range: hcl.Range{
+						Filename: "variable_anatomy_test.tf",
+						Start:    hcl.Pos{Line: 25, Column: 3},
+						End:      hcl.Pos{Line: 25, Column: 14},
+					},
+				},
+			},
+			Fixed: string(fixed),
+		},
+		{
+			Name: "order",
+			Config: `
+rule "eos_variable_anatomy" {
+  enabled  = true
+  output   = ["value", "description"]
+  variable = ["description", "type"]
+}`,
+			Content: string(content),
+			Want: helper.Issues{
+				{
+					Rule:    NewVariableAnatomyRule(),
+					Message: makeVariableAnatomyMessage("description", "type", `variable "tags"`),
+					Range: hcl.Range{
+						Filename: "variable_anatomy_test.tf",
+						Start:    hcl.Pos{Line: 33, Column: 3},
+						End:      hcl.Pos{Line: 33, Column: 14},
+					},
+				},
+				{
+					Rule:    NewVariableAnatomyRule(),
+					Message: makeVariableAnatomyMessage("type", "default", `variable "retention_days"`),
+					Range: hcl.Range{
+						Filename: "variable_anatomy_test.tf",
+						Start:    hcl.Pos{Line: 19, Column: 3},
+						End:      hcl.Pos{Line: 19, Column: 7},
+					},
+				},
+				{
+					Rule:    NewVariableAnatomyRule(),
+					Message: makeVariableAnatomyMessage("description", "default", `variable "retention_days"`),
+					Range: hcl.Range{
+						Filename: "variable_anatomy_test.tf",
+						Start:    hcl.Pos{Line: 20, Column: 3},
+						End:      hcl.Pos{Line: 20, Column: 14},
+					},
+				},
+				{
+					Rule:    NewVariableAnatomyRule(),
+					Message: makeVariableAnatomyMessage("value", "description", `output "bucket_name"`),
+					Range: hcl.Range{
+						Filename: "variable_anatomy_test.tf",
+						Start:    hcl.Pos{Line: 40, Column: 3},
+						End:      hcl.Pos{Line: 40, Column: 8},
+					},
+				},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+
+		// Run the tests and make sure the basic results are found...
+		files := map[string]string{"variable_anatomy_test.tf": tc.Content}
+		if tc.Config != "" {
+			files[".tflint.hcl"] = tc.Config
+		}
+		runner := helper.TestRunner(t, files)
+		rule := NewVariableAnatomyRule()
+
+		// ... no errors.
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		// ... and the expected number of issues.
+		if len(runner.Issues) != len(tc.Want) {
+			t.Logf("Expected %d issues, got %d", len(tc.Want), len(runner.Issues))
+			for i, issue := range runner.Issues {
+				t.Logf("Issue %d: %s at %s", i, issue.Message, issue.Range)
+			}
+			t.Fatalf("Number of issues mismatch: got %d, want %d", len(runner.Issues), len(tc.Want))
+		}
+
+		t.Run(tc.Name, func(t *testing.T) {
+			if *variableAnatomyDeep {
+				helper.AssertIssues(t, tc.Want, runner.Issues)
+			} else {
+				helper.AssertIssuesWithoutRange(t, tc.Want, runner.Issues)
+			}
+
+			// ... and the expected fixes.
+			if tc.Fixed != "" {
+				helper.AssertChanges(t, map[string]string{"variable_anatomy_test.tf": tc.Fixed}, runner.Changes())
+			}
+		})
+	}
+}
+
+func makeVariableAnatomyMessage(name string, before string, block string) string {
+	return fmt.Sprintf("'%s' should come before '%s' in %s.", name, before, block)
+}
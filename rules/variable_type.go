@@ -0,0 +1,190 @@
+// Copyright (c) 2025 Steve Taranto <staranto@gmail.com>.
+// SPDX-License-Identifier: Apache-2.0
+
+package rules
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/logger"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// typedBlocks are the blocks checked by the VariableTypeRule.
+var typedBlocks = []BlockDef{
+	{Typ: "variable", Labels: []string{"name"}, Attributes: []string{"type"}},
+}
+
+// legacyTypes maps the quoted types of Terraform 0.11 to their modern form,
+// as terraform 0.12upgrade rewrote them.
+var legacyTypes = map[string]string{
+	"list":   "list(string)",
+	"map":    "map(string)",
+	"string": "string",
+}
+
+// collectionTypes are the type constructors that take an element type.
+var collectionTypes = []string{"list", "map", "set"}
+
+var defaultVariableTypeConfig = variableTypeRuleConfig{
+	Level:            "warning",
+	ObjectAttributes: 5,
+}
+
+// variableTypeRuleConfig represents the configuration for the
+// VariableTypeRule.
+type variableTypeRuleConfig struct {
+	Level            string `hclext:"level,optional"`
+	ObjectAttributes int    `hclext:"object_attributes,optional"`
+}
+
+// VariableTypeRule checks whether variables have explicit and specific type
+// constraints.
+type VariableTypeRule struct {
+	tflint.DefaultRule
+	Config variableTypeRuleConfig
+}
+
+// Check checks whether the rule conditions are met.
+func (r *VariableTypeRule) Check(runner tflint.Runner) error {
+	if err := runner.DecodeRuleConfig(r.Name(), &r.Config); err != nil {
+		return err
+	}
+
+	return CheckBlocksAndLocals(runner, typedBlocks, r, checkForVariableType)
+}
+
+// checkForVariableType checks the type constraint of a variable.
+func checkForVariableType(runner tflint.Runner, r *VariableTypeRule, block *hclext.Block, typ string, name string, _ string) {
+	if typ != "variable" {
+		return
+	}
+
+	attr, ok := block.Body.Attributes["type"]
+	if !ok {
+		r.emit(runner, fmt.Sprintf("'%s' has no type.", name), block.DefRange)
+		return
+	}
+
+	// In JSON, every type is a quoted string, and none are legacy.
+	expr, ok := attr.Expr.(hclsyntax.Expression)
+	if !ok {
+		return
+	}
+
+	if template, ok := expr.(*hclsyntax.TemplateExpr); ok && template.IsStringLiteral() {
+		value, _ := template.Value(nil)
+		legacy := value.AsString()
+		message := fmt.Sprintf("'%s' has the legacy quoted type \"%s\".", name, legacy)
+		modern, ok := legacyTypes[legacy]
+		if ok {
+			message = withSuggestion(message, modern)
+		}
+		if err := runner.EmitIssueWithFix(r, message, expr.Range(), func(f tflint.Fixer) error {
+			if !ok {
+				return tflint.ErrFixNotSupported
+			}
+			return f.ReplaceText(expr.Range(), modern)
+		}); err != nil {
+			logger.Error(err.Error())
+		}
+		logger.Debug(message)
+		return
+	}
+
+	r.checkType(runner, name, expr)
+}
+
+// checkType reports the uses of 'any' and the collections without an element
+// type anywhere in a type constraint, and the object types, within
+// collections too, that have more attributes than the limit and none of them
+// optional.
+func (r *VariableTypeRule) checkType(runner tflint.Runner, name string, expr hclsyntax.Expression) {
+	switch keyword := hcl.ExprAsKeyword(expr); {
+	case keyword == "any":
+		r.emit(runner, fmt.Sprintf("'%s' has the type 'any'.", name), expr.Range())
+		return
+	case slices.Contains(collectionTypes, keyword):
+		r.emit(runner, fmt.Sprintf("'%s' has the type '%s' without an element type.", name, keyword), expr.Range())
+		return
+	}
+
+	switch expr := expr.(type) {
+	case *hclsyntax.TupleConsExpr:
+		for _, elem := range expr.Exprs {
+			r.checkType(runner, name, elem)
+		}
+	case *hclsyntax.FunctionCallExpr:
+		switch {
+		case expr.Name == "object" && len(expr.Args) == 1:
+			r.checkObject(runner, name, expr)
+		case expr.Name == "optional" && len(expr.Args) > 0:
+			// The second argument of optional() is a default value, not a type.
+			r.checkType(runner, name, expr.Args[0])
+		default:
+			for _, arg := range expr.Args {
+				r.checkType(runner, name, arg)
+			}
+		}
+	}
+}
+
+// checkObject checks the attribute types of an object type, and reports it
+// when it has more attributes than the limit and none of them optional.
+func (r *VariableTypeRule) checkObject(runner tflint.Runner, name string, call *hclsyntax.FunctionCallExpr) {
+	object, ok := call.Args[0].(*hclsyntax.ObjectConsExpr)
+	if !ok {
+		return
+	}
+	optional := false
+	for _, item := range object.Items {
+		if attrCall, ok := item.ValueExpr.(*hclsyntax.FunctionCallExpr); ok && attrCall.Name == "optional" {
+			optional = true
+		}
+		r.checkType(runner, name, item.ValueExpr)
+	}
+
+	if len(object.Items) > r.Config.ObjectAttributes && !optional {
+		message := withSuggestion(fmt.Sprintf("'%s' has an object type with %d attributes and none of them optional.", name, len(object.Items)), "optional()")
+		r.emit(runner, message, call.NameRange)
+	}
+}
+
+// emit reports a problem with the type of a variable.
+func (r *VariableTypeRule) emit(runner tflint.Runner, message string, rng hcl.Range) {
+	if err := runner.EmitIssue(r, message, rng); err != nil {
+		logger.Error(err.Error())
+	}
+	logger.Debug(message)
+}
+
+// NewVariableTypeRule returns a new rule.
+func NewVariableTypeRule() *VariableTypeRule {
+	rule := &VariableTypeRule{}
+	rule.Config = defaultVariableTypeConfig
+	return rule
+}
+
+// Enabled returns whether the rule is enabled by default.
+func (r *VariableTypeRule) Enabled() bool {
+	return true
+}
+
+// Link returns the rule reference link.
+func (r *VariableTypeRule) Link() string {
+	return "https://github.com/staranto/tflint-ruleset-elements-of-style/blob/main/docs/rules/eos_variable_type.md"
+}
+
+// Name returns the rule name.
+func (r *VariableTypeRule) Name() string {
+	return "eos_variable_type"
+}
+
+// Severity returns the rule severity.
+func (r *VariableTypeRule) Severity() tflint.Severity {
+	return toSeverity(r.Config.Level)
+}
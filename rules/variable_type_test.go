@@ -0,0 +1,187 @@
+// Copyright (c) 2025 Steve Taranto <staranto@gmail.com>.
+// SPDX-License-Identifier: Apache-2.0
+
+package rules
+
+import (
+	"flag"
+	"fmt"
+	"testing"
+
+	"os"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+var variableTypeDeep = flag.Bool("variableTypeDeep", false, "enable deep assert")
+
+func TestVariableTypeRule(t *testing.T) {
+	flag.Parse()
+
+	content, _ := os.ReadFile("testdata/variable_type_test.tf")
+	fixed, _ := os.ReadFile("testdata/variable_type_fixed_test.tf")
+
+	issues := helper.Issues{
+		{
+			Rule:    NewVariableTypeRule(),
+			Message: "'region' has no type.",
+			Range: hcl.Range{
+				Filename: "variable_type_test.tf",
+				Start:    hcl.Pos{Line: 4, Column: 1},
+				End:      hcl.Pos{Line: 4, Column: 18},
+			},
+		},
+		{
+			Rule:    NewVariableTypeRule(),
+			Message: "'settings' has the type 'any'.",
+			Range: hcl.Range{
+				Filename: "variable_type_test.tf",
+				Start:    hcl.Pos{Line: 9, Column: 10},
+				End:      hcl.Pos{Line: 9, Column: 13},
+			},
+		},
+		{
+			Rule:    NewVariableTypeRule(),
+			Message: "'tags' has the type 'map' without an element type.",
+			Range: hcl.Range{
+				Filename: "variable_type_test.tf",
+				Start:    hcl.Pos{Line: 13, Column: 10},
+				End:      hcl.Pos{Line: 13, Column: 13},
+			},
+		},
+		{
+			Rule:    NewVariableTypeRule(),
+			Message: makeLegacyTypeMessage("zones", "list", "list(string)"),
+			Range: hcl.Range{
+				Filename: "variable_type_test.tf",
+				Start:    hcl.Pos{Line: 17, Column: 10},
+				End:      hcl.Pos{Line: 17, Column: 16},
+			},
+		},
+		{
+			Rule:    NewVariableTypeRule(),
+			Message: makeLegacyTypeMessage("owner", "string", "string"),
+			Range: hcl.Range{
+				Filename: "variable_type_test.tf",
+				Start:    hcl.Pos{Line: 21, Column: 10},
+				End:      hcl.Pos{Line: 21, Column: 18},
+			},
+		},
+		{
+			Rule:    NewVariableTypeRule(),
+			Message: "'labels' has the type 'any'.",
+			Range: hcl.Range{
+				Filename: "variable_type_test.tf",
+				Start:    hcl.Pos{Line: 36, Column: 14},
+				End:      hcl.Pos{Line: 36, Column: 17},
+			},
+		},
+		{
+			Rule:    NewVariableTypeRule(),
+			Message: "'rules' has the type 'any'.",
+			Range: hcl.Range{
+				Filename: "variable_type_test.tf",
+				Start:    hcl.Pos{Line: 40, Column: 15},
+				End:      hcl.Pos{Line: 40, Column: 18},
+			},
+		},
+		{
+			Rule:    NewVariableTypeRule(),
+			Message: "'network' has the type 'any'.",
+			Range: hcl.Range{
+				Filename: "variable_type_test.tf",
+				Start:    hcl.Pos{Line: 46, Column: 13},
+				End:      hcl.Pos{Line: 46, Column: 16},
+			},
+		},
+		{
+			Rule:    NewVariableTypeRule(),
+			Message: "'listener' has the type 'any'.",
+			Range: hcl.Range{
+				Filename: "variable_type_test.tf",
+				Start:    hcl.Pos{Line: 51, Column: 25},
+				End:      hcl.Pos{Line: 51, Column: 28},
+			},
+		},
+	}
+
+	cases := []struct {
+		Name    string
+		Config  string
+		Content string
+		Want    helper.Issues
+		Fixed   string
+	}{
+		{
+			Name:    "variable_type",
+			Content: string(content),
+			Want: append(append(helper.Issues{}, issues...), &helper.Issue{
+				Rule:    NewVariableTypeRule(),
+				Message: makeObjectTypeMessage("servers", 6),
+				Range: hcl.Range{
+					Filename: "variable_type_test.tf",
+					Start:    hcl.Pos{Line: 25, Column: 15},
+					End:      hcl.Pos{Line: 25, Column: 21},
+				},
+			}),
+			Fixed: string(fixed),
+		},
+		{
+			Name: "object_attributes",
+			Config: `
+rule "eos_variable_type" {
+  enabled           = true
+  object_attributes = 6
+}`,
+			Content: string(content),
+			Want:    issues,
+		},
+	}
+
+	for _, tc := range cases {
+
+		// Run the tests and make sure the basic results are found...
+		files := map[string]string{"variable_type_test.tf": tc.Content}
+		if tc.Config != "" {
+			files[".tflint.hcl"] = tc.Config
+		}
+		runner := helper.TestRunner(t, files)
+		rule := NewVariableTypeRule()
+
+		// ... no errors.
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		// ... and the expected number of issues.
+		if len(runner.Issues) != len(tc.Want) {
+			t.Logf("Expected %d issues, got %d", len(tc.Want), len(runner.Issues))
+			for i, issue := range runner.Issues {
+				t.Logf("Issue %d: %s at %s", i, issue.Message, issue.Range)
+			}
+			t.Fatalf("Number of issues mismatch: got %d, want %d", len(runner.Issues), len(tc.Want))
+		}
+
+		t.Run(tc.Name, func(t *testing.T) {
+			if *variableTypeDeep {
+				helper.AssertIssues(t, tc.Want, runner.Issues)
+			} else {
+				helper.AssertIssuesWithoutRange(t, tc.Want, runner.Issues)
+			}
+
+			// ... and the expected fixes.
+			if tc.Fixed != "" {
+				helper.AssertChanges(t, map[string]string{"variable_type_test.tf": tc.Fixed}, runner.Changes())
+			}
+		})
+	}
+}
+
+func makeLegacyTypeMessage(name string, legacy string, suggestion string) string {
+	return fmt.Sprintf("'%s' has the legacy quoted type \"%s\". Consider '%s'.", name, legacy, suggestion)
+}
+
+func makeObjectTypeMessage(name string, count int) string {
+	return fmt.Sprintf("'%s' has an object type with %d attributes and none of them optional. Consider 'optional()'.", name, count)
+}
@@ -8,6 +8,7 @@
 package terraform
 
 import (
+	"slices"
 	"strings"
 
 	"github.com/hashicorp/hcl/v2"
@@ -290,6 +291,71 @@ func (r *Runner) GetProviderRefs() (map[string]*ProviderRef, hcl.Diagnostics) {
 	return providerRefs, diags
 }
 
+// GetReferences returns all references to variables, local values and data
+// sources in the module's expressions, keyed by address like "var.region",
+// "local.tags" or "data.aws_ami.main".
+func (r *Runner) GetReferences() (map[string]*Reference, hcl.Diagnostics) {
+	refs := map[string]*Reference{}
+
+	diags := r.WalkExpressions(tflint.ExprWalkFunc(func(expr hcl.Expression) hcl.Diagnostics {
+		// For JSON syntax, walker is not implemented,
+		// so extract the hclsyntax.Node that we can walk on.
+		// See https://github.com/hashicorp/hcl/issues/543
+		nodes, wDiags := r.walkableNodesInExpr(expr)
+
+		for _, node := range nodes {
+			visitDiags := hclsyntax.VisitAll(node, func(n hclsyntax.Node) hcl.Diagnostics {
+				traversalExpr, ok := n.(*hclsyntax.ScopeTraversalExpr)
+				if !ok {
+					return nil
+				}
+				name := referenceName(traversalExpr.Traversal)
+				if name == "" {
+					return nil
+				}
+
+				ref, exists := refs[name]
+				if !exists {
+					ref = &Reference{Name: name}
+					refs[name] = ref
+				}
+				// The native walker visits nested expressions again, so
+				// the same traversal can be seen more than once.
+				if !slices.Contains(ref.Ranges, traversalExpr.SrcRange) {
+					ref.Ranges = append(ref.Ranges, traversalExpr.SrcRange)
+				}
+				return nil
+			})
+			wDiags = wDiags.Extend(visitDiags)
+		}
+		return wDiags
+	}))
+
+	return refs, diags
+}
+
+// referenceName returns the address of the variable, local value or data
+// source a traversal refers to, or "" if it refers to something else.
+func referenceName(traversal hcl.Traversal) string {
+	var parts []string
+	for _, step := range traversal[1:] {
+		attr, ok := step.(hcl.TraverseAttr)
+		if !ok {
+			break
+		}
+		parts = append(parts, attr.Name)
+	}
+
+	switch root := traversal.RootName(); {
+	case (root == "var" || root == "local") && len(parts) >= 1:
+		return root + "." + parts[0]
+	case root == "data" && len(parts) >= 2:
+		return root + "." + parts[0] + "." + parts[1]
+	default:
+		return ""
+	}
+}
+
 // walkableNodesInExpr returns hclsyntax.Node from the given expression.
 // If the expression is an hclsyntax expression, it is returned as is.
 // If the expression is a JSON expression, it is parsed and
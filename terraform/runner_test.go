@@ -317,3 +317,91 @@ output "foo" {
 		})
 	}
 }
+
+func TestGetReferences(t *testing.T) {
+	tests := []struct {
+		name    string
+		json    bool
+		content string
+		want    map[string]*Reference
+	}{
+		{
+			name: "variable",
+			content: `
+output "region" {
+  value = var.region
+}`,
+			want: map[string]*Reference{
+				"var.region": {Name: "var.region", Ranges: []hcl.Range{{Filename: "main.tf", Start: hcl.Pos{Line: 3, Column: 11}, End: hcl.Pos{Line: 3, Column: 21}}}},
+			},
+		},
+		{
+			name: "local in template",
+			content: `
+output "name" {
+  value = "${local.prefix}-${local.prefix}"
+}`,
+			want: map[string]*Reference{
+				"local.prefix": {Name: "local.prefix", Ranges: []hcl.Range{
+					{Filename: "main.tf", Start: hcl.Pos{Line: 3, Column: 14}, End: hcl.Pos{Line: 3, Column: 26}},
+					{Filename: "main.tf", Start: hcl.Pos{Line: 3, Column: 30}, End: hcl.Pos{Line: 3, Column: 42}},
+				}},
+			},
+		},
+		{
+			name: "data source",
+			content: `
+resource "aws_instance" "main" {
+  ami = data.aws_ami.main[0].id
+}`,
+			want: map[string]*Reference{
+				"data.aws_ami.main": {Name: "data.aws_ami.main", Ranges: []hcl.Range{{Filename: "main.tf", Start: hcl.Pos{Line: 3, Column: 9}, End: hcl.Pos{Line: 3, Column: 32}}}},
+			},
+		},
+		{
+			name: "other references",
+			content: `
+output "id" {
+  value = [for id in aws_instance.main[*].id : upper(id)]
+}`,
+			want: map[string]*Reference{},
+		},
+		{
+			name: "variable in JSON",
+			json: true,
+			content: `
+{
+  "output": {
+    "region": {
+      "value": "${var.region}"
+	}
+  }
+}`,
+			want: map[string]*Reference{
+				"var.region": {Name: "var.region", Ranges: []hcl.Range{{Filename: "main.tf.json", Start: hcl.Pos{Line: 3, Column: 15}, End: hcl.Pos{Line: 3, Column: 25}}}},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			filename := "main.tf"
+			if test.json {
+				filename += ".json"
+			}
+			runner := NewRunner(helper.TestRunner(t, map[string]string{filename: test.content}))
+
+			got, diags := runner.GetReferences()
+			if diags.HasErrors() {
+				t.Fatal(diags)
+			}
+
+			opts := []cmp.Option{
+				cmpopts.IgnoreFields(hcl.Pos{}, "Byte"),
+			}
+			if diff := cmp.Diff(got, test.want, opts...); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
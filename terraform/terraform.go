@@ -77,6 +77,13 @@ type ProviderRef struct {
 	DefRange hcl.Range
 }
 
+// Reference represents the references to a variable, local value or data
+// source, like `var.region`, `local.tags` or `data.aws_ami.main`.
+type Reference struct {
+	Name   string
+	Ranges []hcl.Range
+}
+
 // @see https://github.com/hashicorp/terraform/blob/v1.2.7/internal/configs/resource.go#L624-L695
 func decodeProviderRef(expr hcl.Expression, defRange hcl.Range) (*ProviderRef, hcl.Diagnostics) {
 	expr, diags := shimTraversalInString(expr)